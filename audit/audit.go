@@ -0,0 +1,79 @@
+// Package audit records who accessed or modified which health data, for every gRPC
+// call and every mutation consumed from Kafka, in the append-only audit log.
+package audit
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+)
+
+// Sources of audit entries.
+const (
+	SourceGRPC  = "grpc"
+	SourceKafka = "kafka"
+)
+
+// Actions of audit entries.
+const (
+	ActionCreate  = "create"
+	ActionRead    = "read"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionRestore = "restore"
+	ActionList    = "list"
+)
+
+// writeTimeout bounds how long an entry may take to be written.
+const writeTimeout = 5 * time.Second
+
+// Logger appends entries to the audit log.
+type Logger struct {
+	repo storage.AuditLogRepoI
+}
+
+// NewLogger creates a Logger writing to the given repository.
+func NewLogger(repo storage.AuditLogRepoI) *Logger {
+	return &Logger{repo: repo}
+}
+
+// Record appends an entry to the audit log. The entry is written even if ctx has been
+// cancelled, so that aborted calls are audited too; failures are only logged.
+func (l *Logger) Record(ctx context.Context, entry *health.AuditEntry) {
+	if entry.Timestamp == "" {
+		entry.Timestamp = time.Now().UTC().Format(time.RFC3339Nano)
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), writeTimeout)
+	defer cancel()
+	if err := l.repo.AppendAuditEntry(ctx, entry); err != nil {
+		log.Printf("failed to write audit entry %s %s %s/%s by %s: %v",
+			entry.Source, entry.Action, entry.ResourceType, entry.ResourceId, entry.Actor, err)
+	}
+}
+
+type entryKey struct{}
+
+// withEntry returns a copy of ctx carrying the entry of the current call, so that
+// inner interceptors can complete it.
+func withEntry(ctx context.Context, entry *health.AuditEntry) context.Context {
+	return context.WithValue(ctx, entryKey{}, entry)
+}
+
+// SetActor records the authenticated caller of the current call.
+func SetActor(ctx context.Context, actor string, roles []string) {
+	if entry, ok := ctx.Value(entryKey{}).(*health.AuditEntry); ok {
+		entry.Actor = actor
+		entry.ActorRoles = roles
+	}
+}
+
+// SetOwner records the owner of the data accessed by the current call, as stored.
+// It takes precedence over the user_id of the request.
+func SetOwner(ctx context.Context, userID string) {
+	if entry, ok := ctx.Value(entryKey{}).(*health.AuditEntry); ok {
+		entry.UserId = userID
+	}
+}
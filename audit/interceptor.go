@@ -0,0 +1,115 @@
+package audit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// resourceTypes maps the gRPC services to the resource type they expose.
+var resourceTypes = map[string]string{
+	health.MedicalRecordService_ServiceDesc.ServiceName:        "medical_record",
+	health.GeneticDataService_ServiceDesc.ServiceName:          "genetic_data",
+	health.LifestyleDataService_ServiceDesc.ServiceName:        "lifestyle_data",
+	health.WearableDataService_ServiceDesc.ServiceName:         "wearable_data",
+	health.HealthRecommendationService_ServiceDesc.ServiceName: "health_recommendation",
+	health.HealthMonitoringService_ServiceDesc.ServiceName:     "health_summary",
+	health.NotificationService_ServiceDesc.ServiceName:         "notification",
+	health.AuditService_ServiceDesc.ServiceName:                "audit_log",
+}
+
+// Unary returns the interceptor auditing unary calls. It must run before the
+// authentication interceptor, so that rejected calls are audited as well.
+func (l *Logger) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		entry := newEntry(info.FullMethod)
+		resp, err := handler(withEntry(ctx, entry), req)
+
+		describe(entry, req)
+		if err == nil {
+			describe(entry, resp)
+		}
+		l.Record(ctx, complete(entry, err))
+		return resp, err
+	}
+}
+
+// Stream returns the interceptor auditing streaming calls, recorded when the stream ends.
+func (l *Logger) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		entry := newEntry(info.FullMethod)
+		stream := &auditedStream{ServerStream: ss, ctx: withEntry(ss.Context(), entry), entry: entry}
+		err := handler(srv, stream)
+		l.Record(ss.Context(), complete(entry, err))
+		return err
+	}
+}
+
+// newEntry starts the entry of a call to the given method.
+func newEntry(fullMethod string) *health.AuditEntry {
+	entry := &health.AuditEntry{Source: SourceGRPC, Method: fullMethod, Action: ActionRead}
+
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	entry.ResourceType = resourceTypes[service]
+	switch {
+	case strings.HasPrefix(method, "Create"):
+		entry.Action = ActionCreate
+	case strings.HasPrefix(method, "Update"), strings.HasPrefix(method, "Mark"):
+		entry.Action = ActionUpdate
+	case strings.HasPrefix(method, "Delete"):
+		entry.Action = ActionDelete
+	case strings.HasPrefix(method, "Restore"):
+		entry.Action = ActionRestore
+	case strings.HasPrefix(method, "List"), strings.HasPrefix(method, "Query"):
+		entry.Action = ActionList
+	}
+	return entry
+}
+
+// describe fills in the resource id and owner of the entry from a request or
+// response message, without overwriting what is already known.
+func describe(entry *health.AuditEntry, msg interface{}) {
+	if m, ok := msg.(interface{ GetId() string }); ok && entry.ResourceId == "" {
+		entry.ResourceId = m.GetId()
+	}
+	if m, ok := msg.(interface{ GetUserId() string }); ok && entry.UserId == "" {
+		entry.UserId = m.GetUserId()
+	}
+}
+
+// complete records the outcome of the call.
+func complete(entry *health.AuditEntry, err error) *health.AuditEntry {
+	st := status.Convert(err)
+	entry.Outcome = st.Code().String()
+	if err != nil {
+		entry.Error = st.Message()
+	}
+	return entry
+}
+
+// auditedStream carries the entry in its context and describes it from the first
+// message received from the client.
+type auditedStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	entry    *health.AuditEntry
+	received bool
+}
+
+func (s *auditedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *auditedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !s.received {
+		s.received = true
+		describe(s.entry, m)
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/health-analytics-service/health-analytics-service/audit"
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// owner identifies whose data a request touches.
+type owner struct {
+	userID   string
+	doctorID string // Only set for medical records
+}
+
+// ownerLookup loads the owner of a stored entity by id.
+type ownerLookup func(ctx context.Context, id string) (owner, error)
+
+// includingDeleted makes a lookup find soft-deleted entities too, for restores.
+func includingDeleted(lookup ownerLookup) ownerLookup {
+	return func(ctx context.Context, id string) (owner, error) {
+		return lookup(storage.WithDeleted(ctx), id)
+	}
+}
+
+// maskedUpdate is an update request of an entity with an update_mask.
+type maskedUpdate interface {
+	GetUserId() string
+	GetUpdateMask() *fieldmaskpb.FieldMask
+}
+
+// updatedOwner returns the owner of an entity after a masked update.
+func updatedOwner(stored owner, update maskedUpdate) owner {
+	updated := stored
+	if storage.MaskIncludes(update.GetUpdateMask(), "user_id") {
+		updated.userID = update.GetUserId()
+	}
+	if record, ok := update.(*health.MedicalRecord); ok && storage.MaskIncludes(record.UpdateMask, "doctor_id") {
+		updated.doctorID = record.DoctorId
+	}
+	return updated
+}
+
+// Authorizer decides which users' data a principal may access:
+//   - admins may access everything;
+//   - everyone may access their own data (user_id equal to their subject);
+//   - doctors may access the medical records whose doctor_id is theirs, and the other
+//     health data of the patients they have at least one such medical record for.
+//     The doctor_id a request writes grants nothing, so only a treating doctor (or
+//     an admin) may write the medical records of a patient.
+//
+// Notifications and audit logs are personal, so only their owner (or an admin) may
+// access them.
+type Authorizer struct {
+	storage storage.StorageI
+	// owners maps the methods addressing an entity by id to the lookup of its owner,
+	// so that the stored owner is checked rather than the one sent by the caller.
+	owners map[string]ownerLookup
+}
+
+// NewAuthorizer creates an Authorizer resolving ownership from the storage.
+func NewAuthorizer(storage storage.StorageI) *Authorizer {
+	medicalRecord := func(ctx context.Context, id string) (owner, error) {
+		record, err := storage.MedicalRecord().GetMedicalRecord(ctx, id)
+		if err != nil {
+			return owner{}, err
+		}
+		return owner{userID: record.UserId, doctorID: record.DoctorId}, nil
+	}
+	geneticData := func(ctx context.Context, id string) (owner, error) {
+		data, err := storage.GeneticData().GetGeneticData(ctx, id)
+		if err != nil {
+			return owner{}, err
+		}
+		return owner{userID: data.UserId}, nil
+	}
+	lifestyleData := func(ctx context.Context, id string) (owner, error) {
+		data, err := storage.LifestyleData().GetLifestyleData(ctx, id)
+		if err != nil {
+			return owner{}, err
+		}
+		return owner{userID: data.UserId}, nil
+	}
+	wearableData := func(ctx context.Context, id string) (owner, error) {
+		data, err := storage.WearableData().GetWearableData(ctx, id)
+		if err != nil {
+			return owner{}, err
+		}
+		return owner{userID: data.UserId}, nil
+	}
+	healthRecommendation := func(ctx context.Context, id string) (owner, error) {
+		recommendation, err := storage.HealthRecommendation().GetHealthRecommendation(ctx, id)
+		if err != nil {
+			return owner{}, err
+		}
+		return owner{userID: recommendation.UserId}, nil
+	}
+
+	return &Authorizer{
+		storage: storage,
+		owners: map[string]ownerLookup{
+			health.MedicalRecordService_GetMedicalRecord_FullMethodName:                   medicalRecord,
+			health.MedicalRecordService_UpdateMedicalRecord_FullMethodName:                medicalRecord,
+			health.MedicalRecordService_DeleteMedicalRecord_FullMethodName:                medicalRecord,
+			health.MedicalRecordService_RestoreMedicalRecord_FullMethodName:               includingDeleted(medicalRecord),
+			health.MedicalRecordService_ListMedicalRecordVersions_FullMethodName:          medicalRecord,
+			health.MedicalRecordService_GetMedicalRecordVersion_FullMethodName:            medicalRecord,
+			health.GeneticDataService_GetGeneticData_FullMethodName:                       geneticData,
+			health.GeneticDataService_UpdateGeneticData_FullMethodName:                    geneticData,
+			health.GeneticDataService_DeleteGeneticData_FullMethodName:                    geneticData,
+			health.GeneticDataService_RestoreGeneticData_FullMethodName:                   includingDeleted(geneticData),
+			health.LifestyleDataService_GetLifestyleData_FullMethodName:                   lifestyleData,
+			health.LifestyleDataService_UpdateLifestyleData_FullMethodName:                lifestyleData,
+			health.LifestyleDataService_DeleteLifestyleData_FullMethodName:                lifestyleData,
+			health.LifestyleDataService_RestoreLifestyleData_FullMethodName:               includingDeleted(lifestyleData),
+			health.WearableDataService_GetWearableData_FullMethodName:                     wearableData,
+			health.WearableDataService_UpdateWearableData_FullMethodName:                  wearableData,
+			health.WearableDataService_DeleteWearableData_FullMethodName:                  wearableData,
+			health.WearableDataService_RestoreWearableData_FullMethodName:                 includingDeleted(wearableData),
+			health.HealthRecommendationService_GetHealthRecommendation_FullMethodName:     healthRecommendation,
+			health.HealthRecommendationService_UpdateHealthRecommendation_FullMethodName:  healthRecommendation,
+			health.HealthRecommendationService_DeleteHealthRecommendation_FullMethodName:  healthRecommendation,
+			health.HealthRecommendationService_RestoreHealthRecommendation_FullMethodName: includingDeleted(healthRecommendation),
+		},
+	}
+}
+
+// Authorize checks that the principal may perform the request of the given method.
+func (a *Authorizer) Authorize(ctx context.Context, p *Principal, fullMethod string, req interface{}) error {
+	if p.HasRole(RoleAdmin) {
+		return nil
+	}
+	personal := strings.HasPrefix(fullMethod, "/"+health.NotificationService_ServiceDesc.ServiceName+"/") ||
+		strings.HasPrefix(fullMethod, "/"+health.AuditService_ServiceDesc.ServiceName+"/")
+
+	// Entities addressed by id are checked against their stored owner; updates must
+	// also not move the entity to another user, which is checked below.
+	if lookup, ok := a.owners[fullMethod]; ok {
+		byID, ok := req.(interface{ GetId() string })
+		if !ok {
+			return errs.PermissionDenied("UNSUPPORTED_REQUEST", "cannot authorize request")
+		}
+		stored, err := lookup(ctx, byID.GetId())
+		if err != nil {
+			return errs.Wrap(err, "failed to authorize request")
+		}
+		audit.SetOwner(ctx, stored.userID)
+		if err := a.authorizeOwner(ctx, p, stored, personal); err != nil {
+			return err
+		}
+
+		// Partial updates keep the stored values of the fields they do not mask
+		if update, ok := req.(maskedUpdate); ok && len(update.GetUpdateMask().GetPaths()) > 0 {
+			if updated := updatedOwner(stored, update); updated != stored {
+				return a.authorizeOwner(ctx, p, owner{userID: updated.userID}, personal)
+			}
+			return nil
+		}
+	}
+
+	switch r := req.(type) {
+	case *health.ByIdRequest, *health.ListMedicalRecordVersionsRequest, *health.GetMedicalRecordVersionRequest:
+		if _, ok := a.owners[fullMethod]; ok {
+			return nil // Checked against the stored owner above
+		}
+		return errs.PermissionDenied("UNSUPPORTED_REQUEST", "cannot authorize request")
+	case *health.MedicalRecord:
+		// The doctor_id being written is not checked: it would let any doctor create
+		// the record that makes them a treating doctor of the patient
+		return a.authorizeOwner(ctx, p, owner{userID: r.UserId}, personal)
+	case *health.ListMedicalRecordsRequest:
+		if r.DoctorId != "" && r.DoctorId == p.Subject && p.HasRole(RoleDoctor) {
+			return nil // A doctor listing the records assigned to them
+		}
+		return a.authorizeOwner(ctx, p, owner{userID: r.UserId}, personal)
+	case interface{ GetUserId() string }:
+		return a.authorizeOwner(ctx, p, owner{userID: r.GetUserId()}, personal)
+	default:
+		return errs.PermissionDenied("UNSUPPORTED_REQUEST", "cannot authorize request")
+	}
+}
+
+// authorizeOwner checks that the principal may access the data of the owner.
+func (a *Authorizer) authorizeOwner(ctx context.Context, p *Principal, o owner, personal bool) error {
+	if o.userID != "" && o.userID == p.Subject {
+		return nil
+	}
+	if personal || !p.HasRole(RoleDoctor) {
+		return errs.PermissionDenied("NOT_OWNER", "access to the data of user %q is denied", o.userID)
+	}
+
+	if o.doctorID != "" && o.doctorID == p.Subject {
+		return nil
+	}
+	if o.userID == "" {
+		return errs.PermissionDenied("NOT_OWNER", "access to the data of all users is denied")
+	}
+	treats, err := a.isTreating(ctx, p.Subject, o.userID)
+	if err != nil {
+		return errs.Wrap(err, "failed to authorize request")
+	}
+	if !treats {
+		return errs.PermissionDenied("NOT_TREATING_DOCTOR", "doctor %q has no medical records of user %q", p.Subject, o.userID)
+	}
+	return nil
+}
+
+// isTreating reports whether the doctor has a medical record of the patient.
+func (a *Authorizer) isTreating(ctx context.Context, doctorID, userID string) (bool, error) {
+	records, err := a.storage.MedicalRecord().ListMedicalRecords(ctx, &health.ListMedicalRecordsRequest{
+		UserId:   userID,
+		DoctorId: doctorID,
+		PageSize: 1,
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(records.MedicalRecords) > 0, nil
+}
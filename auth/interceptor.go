@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/health-analytics-service/health-analytics-service/audit"
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Interceptor authenticates every gRPC call with the bearer token of its
+// "authorization" metadata and authorizes its request messages.
+type Interceptor struct {
+	verifier   *Verifier
+	authorizer *Authorizer
+}
+
+// NewInterceptor creates an Interceptor.
+func NewInterceptor(verifier *Verifier, authorizer *Authorizer) *Interceptor {
+	return &Interceptor{verifier: verifier, authorizer: authorizer}
+}
+
+// Unary returns the interceptor of unary calls.
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		p, err := i.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		audit.SetActor(ctx, p.Subject, p.Roles)
+		ctx = storage.WithActor(NewContext(ctx, p), p.Subject)
+		if err := i.authorizer.Authorize(ctx, p, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the interceptor of streaming calls. Every message received from
+// the client is authorized.
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		p, err := i.authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		audit.SetActor(ss.Context(), p.Subject, p.Roles)
+		return handler(srv, &authorizedStream{
+			ServerStream: ss,
+			ctx:          storage.WithActor(NewContext(ss.Context(), p), p.Subject),
+			principal:    p,
+			method:       info.FullMethod,
+			authorizer:   i.authorizer,
+		})
+	}
+}
+
+// authenticate verifies the bearer token of the call.
+func (i *Interceptor) authenticate(ctx context.Context) (*Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, errs.Unauthenticated("missing authorization token")
+	}
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok {
+		return nil, errs.Unauthenticated("authorization must be a bearer token")
+	}
+	p, err := i.verifier.Verify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, errs.Unauthenticated("invalid authorization token: %v", err)
+	}
+	return p, nil
+}
+
+// authorizedStream carries the principal in its context and authorizes every
+// received message.
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	principal  *Principal
+	method     string
+	authorizer *Authorizer
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.authorizer.Authorize(s.ctx, s.principal, s.method, m)
+}
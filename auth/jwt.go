@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/health-analytics-service/health-analytics-service/config"
+)
+
+// Claims are the JWT claims accepted by the service.
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles"`
+}
+
+// Verifier validates signed tokens and extracts their principal.
+type Verifier struct {
+	key     interface{}
+	methods []string
+	options []jwt.ParserOption
+}
+
+// NewVerifier creates a Verifier from the JWT settings of the configuration. Tokens
+// are verified with the HMAC secret (HS256/384/512) or, when a public key file is
+// configured instead, with its RSA key (RS256/384/512).
+func NewVerifier(cfg config.Config) (*Verifier, error) {
+	v := &Verifier{}
+	switch {
+	case cfg.JWTSecret != "" && cfg.JWTPublicKeyFile != "":
+		return nil, errors.New("JWT_SECRET and JWT_PUBLIC_KEY_FILE are mutually exclusive")
+	case cfg.JWTSecret != "":
+		v.key = []byte(cfg.JWTSecret)
+		v.methods = []string{"HS256", "HS384", "HS512"}
+	case cfg.JWTPublicKeyFile != "":
+		pem, err := os.ReadFile(cfg.JWTPublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read JWT public key: %w", err)
+		}
+		key, err := jwt.ParseRSAPublicKeyFromPEM(pem)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JWT public key: %w", err)
+		}
+		v.key = key
+		v.methods = []string{"RS256", "RS384", "RS512"}
+	default:
+		return nil, errors.New("either JWT_SECRET or JWT_PUBLIC_KEY_FILE must be set")
+	}
+
+	v.options = []jwt.ParserOption{jwt.WithValidMethods(v.methods), jwt.WithExpirationRequired()}
+	if cfg.JWTIssuer != "" {
+		v.options = append(v.options, jwt.WithIssuer(cfg.JWTIssuer))
+	}
+	if cfg.JWTAudience != "" {
+		v.options = append(v.options, jwt.WithAudience(cfg.JWTAudience))
+	}
+	return v, nil
+}
+
+// Verify checks the signature and claims of a token and returns its principal.
+func (v *Verifier) Verify(token string) (*Principal, error) {
+	claims := &Claims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return v.key, nil
+	}, v.options...); err != nil {
+		return nil, err
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+	return &Principal{Subject: claims.Subject, Roles: claims.Roles}, nil
+}
@@ -0,0 +1,41 @@
+// Package auth authenticates gRPC callers with JWTs and authorizes their access to
+// the health data of a user.
+package auth
+
+import "context"
+
+// Roles carried in the "roles" claim of a token.
+const (
+	RolePatient = "patient"
+	RoleDoctor  = "doctor"
+	RoleAdmin   = "admin"
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	Subject string   // The user id of the caller (the "sub" claim)
+	Roles   []string // The roles of the caller
+}
+
+// HasRole reports whether the principal has the given role.
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+type principalKey struct{}
+
+// NewContext returns a copy of ctx carrying the principal.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal of an authenticated request.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
@@ -0,0 +1,153 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/health-analytics-service/health-analytics-service/auth"
+	"github.com/health-analytics-service/health-analytics-service/config"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage/memory"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const secret = "test-secret"
+
+func signToken(t *testing.T, subject string, roles ...string) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, auth.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Roles: roles,
+	})
+	signed, err := token.SignedString([]byte(secret))
+	assert.NoError(t, err)
+	return signed
+}
+
+func TestInterceptor(t *testing.T) {
+	cfg := config.Load()
+	cfg.JWTSecret = secret
+	cfg.JWTPublicKeyFile = ""
+	cfg.JWTIssuer = ""
+	cfg.JWTAudience = ""
+
+	storage := memory.NewStorage()
+	verifier, err := auth.NewVerifier(cfg)
+	assert.NoError(t, err)
+	interceptor := auth.NewInterceptor(verifier, auth.NewAuthorizer(storage)).Unary()
+
+	patientID := uuid.NewString()
+	doctorID := uuid.NewString()
+	record, err := storage.MedicalRecord().CreateMedicalRecord(context.Background(), &health.MedicalRecord{
+		UserId:     patientID,
+		RecordType: "Diagnosis",
+		RecordDate: "2024-03-01",
+		DoctorId:   doctorID,
+	})
+	assert.NoError(t, err)
+	defer storage.MedicalRecord().DeleteMedicalRecord(context.Background(), record.Id)
+
+	// call runs the interceptor and returns the status code of the call
+	call := func(token, method string, req interface{}) codes.Code {
+		ctx := context.Background()
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+		}
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			p, ok := auth.FromContext(ctx)
+			assert.True(t, ok)
+			assert.NotEmpty(t, p.Subject)
+			return nil, nil
+		})
+		return status.Code(err)
+	}
+	getRecord := health.MedicalRecordService_GetMedicalRecord_FullMethodName
+	listGenetic := health.GeneticDataService_ListGeneticData_FullMethodName
+	byID := &health.ByIdRequest{Id: record.Id}
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		assert.Equal(t, codes.Unauthenticated, call("", getRecord, byID))
+		assert.Equal(t, codes.Unauthenticated, call("not-a-token", getRecord, byID))
+	})
+
+	t.Run("Patient", func(t *testing.T) {
+		assert.Equal(t, codes.OK, call(signToken(t, patientID, auth.RolePatient), getRecord, byID))
+		assert.Equal(t, codes.OK, call(signToken(t, patientID, auth.RolePatient), listGenetic, &health.ListGeneticDataRequest{UserId: patientID}))
+		assert.Equal(t, codes.PermissionDenied, call(signToken(t, uuid.NewString(), auth.RolePatient), getRecord, byID))
+		assert.Equal(t, codes.PermissionDenied, call(signToken(t, patientID, auth.RolePatient), listGenetic, &health.ListGeneticDataRequest{}))
+	})
+
+	t.Run("Doctor", func(t *testing.T) {
+		doctor := signToken(t, doctorID, auth.RoleDoctor)
+		assert.Equal(t, codes.OK, call(doctor, getRecord, byID))
+		assert.Equal(t, codes.OK, call(doctor, listGenetic, &health.ListGeneticDataRequest{UserId: patientID}))
+		assert.Equal(t, codes.OK, call(doctor, health.MedicalRecordService_ListMedicalRecords_FullMethodName, &health.ListMedicalRecordsRequest{DoctorId: doctorID}))
+		assert.Equal(t, codes.PermissionDenied, call(doctor, listGenetic, &health.ListGeneticDataRequest{UserId: uuid.NewString()}))
+		assert.Equal(t, codes.PermissionDenied, call(doctor, health.NotificationService_ListNotifications_FullMethodName, &health.ListNotificationsRequest{UserId: patientID}))
+
+		other := signToken(t, uuid.NewString(), auth.RoleDoctor)
+		assert.Equal(t, codes.PermissionDenied, call(other, getRecord, byID))
+	})
+
+	t.Run("DoctorWrites", func(t *testing.T) {
+		createRecord := health.MedicalRecordService_CreateMedicalRecord_FullMethodName
+		updateRecord := health.MedicalRecordService_UpdateMedicalRecord_FullMethodName
+		doctor := signToken(t, doctorID, auth.RoleDoctor)
+		assert.Equal(t, codes.OK, call(doctor, createRecord, &health.MedicalRecord{UserId: patientID, DoctorId: doctorID}))
+
+		// A doctor cannot become a treating doctor by naming themselves in a record
+		otherID := uuid.NewString()
+		other := signToken(t, otherID, auth.RoleDoctor)
+		assert.Equal(t, codes.PermissionDenied, call(other, createRecord, &health.MedicalRecord{UserId: patientID, DoctorId: otherID}))
+		assert.Equal(t, codes.PermissionDenied, call(doctor, createRecord, &health.MedicalRecord{UserId: uuid.NewString(), DoctorId: doctorID}))
+
+		// Nor by moving one of their records to another patient
+		assert.Equal(t, codes.PermissionDenied, call(doctor, updateRecord, &health.MedicalRecord{
+			Id: record.Id, UserId: uuid.NewString(), UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"user_id"}},
+		}))
+		assert.Equal(t, codes.PermissionDenied, call(doctor, updateRecord, &health.MedicalRecord{Id: record.Id, UserId: uuid.NewString(), DoctorId: doctorID}))
+
+		admin := signToken(t, uuid.NewString(), auth.RoleAdmin)
+		assert.Equal(t, codes.OK, call(admin, createRecord, &health.MedicalRecord{UserId: patientID, DoctorId: otherID}))
+	})
+
+	t.Run("Admin", func(t *testing.T) {
+		admin := signToken(t, uuid.NewString(), auth.RoleAdmin)
+		assert.Equal(t, codes.OK, call(admin, getRecord, byID))
+		assert.Equal(t, codes.OK, call(admin, listGenetic, &health.ListGeneticDataRequest{}))
+	})
+}
+
+func TestVerifier(t *testing.T) {
+	verifier, err := auth.NewVerifier(config.Config{JWTSecret: secret})
+	assert.NoError(t, err)
+
+	p, err := verifier.Verify(signToken(t, "user-1", auth.RoleDoctor))
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", p.Subject)
+	assert.True(t, p.HasRole(auth.RoleDoctor))
+
+	// Tokens without an expiry or signed with another algorithm are rejected
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{"sub": "user-1"}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	assert.NoError(t, err)
+	_, err = verifier.Verify(unsigned)
+	assert.Error(t, err)
+
+	noExpiry, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "user-1"}).SignedString([]byte(secret))
+	assert.NoError(t, err)
+	_, err = verifier.Verify(noExpiry)
+	assert.Error(t, err)
+
+	_, err = auth.NewVerifier(config.Config{})
+	assert.Error(t, err)
+}
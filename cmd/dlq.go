@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/config"
+	consumer "github.com/health-analytics-service/health-analytics-service/kafka"
+)
+
+// runDLQReplay replays dead-lettered messages back into their source topic:
+//
+//	myapp dlq-replay -topic <source topic> [-limit N] [-idle 10s]
+func runDLQReplay(cfg config.Config, args []string) {
+	fs := flag.NewFlagSet("dlq-replay", flag.ExitOnError)
+	topic := fs.String("topic", "", "source topic whose dead-letter topic is replayed")
+	limit := fs.Int("limit", 0, "maximum number of messages to replay (0 for all)")
+	idle := fs.Duration("idle", 10*time.Second, "stop after no message arrived for this long")
+	fs.Parse(args)
+
+	if *topic == "" {
+		log.Fatal("dlq-replay: -topic is required")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	policy := consumer.RetryPolicyFromConfig(cfg)
+	replayed, err := consumer.ReplayDeadLetters(ctx, cfg.KafkaBrokers, *topic+policy.DLQSuffix, policy, *idle, *limit)
+	if err != nil {
+		log.Fatalf("dlq-replay: %v (replayed %d message(s))", err, replayed)
+	}
+	log.Printf("dlq-replay: replayed %d message(s) from %s", replayed, *topic+policy.DLQSuffix)
+}
@@ -5,11 +5,18 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"os"
+	_ "time/tzdata" // Embed the time zone database for summary requests
 
+	"github.com/health-analytics-service/health-analytics-service/audit"
+	"github.com/health-analytics-service/health-analytics-service/auth"
 	"github.com/health-analytics-service/health-analytics-service/config"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
 	consumer "github.com/health-analytics-service/health-analytics-service/kafka"
+	"github.com/health-analytics-service/health-analytics-service/retention"
+	"github.com/health-analytics-service/health-analytics-service/storage"
 	mongodb "github.com/health-analytics-service/health-analytics-service/storage/mongo"
+	"github.com/health-analytics-service/health-analytics-service/storage/postgres"
 	"github.com/health-analytics-service/health-analytics-service/storage/redis"
 
 	"github.com/health-analytics-service/health-analytics-service/service"
@@ -20,10 +27,26 @@ import (
 func main() {
 	cfg := config.Load()
 
-	// Initialize MongoDB storage
-	mongoStorage, err := mongodb.NewMongoStorage(cfg)
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "dlq-replay":
+			runDLQReplay(cfg, os.Args[2:])
+			return
+		case "reencrypt":
+			runReencrypt(cfg, os.Args[2:])
+			return
+		case "migrate":
+			runMigrate(cfg, os.Args[2:])
+			return
+		default:
+			log.Fatalf("unknown command %q", os.Args[1])
+		}
+	}
+
+	// Initialize the configured storage backend
+	healthStorage, err := newStorage(cfg)
 	if err != nil {
-		log.Fatalf("failed to initialize MongoDB storage: %v", err)
+		log.Fatalf("failed to initialize %s storage: %v", cfg.StorageBackend, err)
 	}
 	redisClient, err := redis.Connect(&cfg)
 	if err != nil {
@@ -31,11 +54,16 @@ func main() {
 	}
 	defer redisClient.Close()
 	// Initialize Kafka consumers
-	geneticDataConsumer := consumer.NewGeneticDataConsumer(cfg.KafkaBrokers, cfg.KafkaGeneticDataTopic, mongoStorage, redisClient)
-	healthRecommendationConsumer := consumer.NewHealthRecommendationConsumer(cfg.KafkaBrokers, cfg.KafkaHealthRecommendationTopic, mongoStorage, redisClient)
-	lifestyleDataConsumer := consumer.NewLifestyleDataConsumer(cfg.KafkaBrokers, cfg.KafkaLifestyleDataTopic, mongoStorage, redisClient)
-	medicalRecordConsumer := consumer.NewMedicalRecordConsumer(cfg.KafkaBrokers, cfg.KafkaMedicalRecordTopic, mongoStorage, redisClient)
-	wearableDataConsumer := consumer.NewWearableDataConsumer(cfg.KafkaBrokers, cfg.KafkaWearableDataTopic, mongoStorage)
+	geneticDataConsumer := consumer.NewGeneticDataConsumer(cfg.KafkaBrokers, cfg.KafkaGeneticDataTopic, healthStorage, redisClient,
+		consumer.OptionsFromConfig(cfg, cfg.KafkaGeneticDataGroupID))
+	healthRecommendationConsumer := consumer.NewHealthRecommendationConsumer(cfg.KafkaBrokers, cfg.KafkaHealthRecommendationTopic, healthStorage, redisClient,
+		consumer.OptionsFromConfig(cfg, cfg.KafkaHealthRecommendationGroupID))
+	lifestyleDataConsumer := consumer.NewLifestyleDataConsumer(cfg.KafkaBrokers, cfg.KafkaLifestyleDataTopic, healthStorage, redisClient,
+		consumer.OptionsFromConfig(cfg, cfg.KafkaLifestyleDataGroupID))
+	medicalRecordConsumer := consumer.NewMedicalRecordConsumer(cfg.KafkaBrokers, cfg.KafkaMedicalRecordTopic, healthStorage, redisClient,
+		consumer.OptionsFromConfig(cfg, cfg.KafkaMedicalRecordGroupID))
+	wearableDataConsumer := consumer.NewWearableDataConsumer(cfg.KafkaBrokers, cfg.KafkaWearableDataTopic, healthStorage, redisClient,
+		consumer.OptionsFromConfig(cfg, cfg.KafkaWearableDataGroupID))
 
 	// Start consumers in separate goroutines
 	go func() {
@@ -68,24 +96,53 @@ func main() {
 		}
 	}()
 
+	// Purge soft-deleted data once its retention period has passed
+	go retention.PurgerFromConfig(cfg, healthStorage.Retention()).Run(context.Background())
+
 	// Initialize gRPC server
 	lis, err := net.Listen("tcp", cfg.GRPCPort)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	s := grpc.NewServer()
+	// Audit, authenticate and authorize every call. The audit interceptor runs first
+	// so that rejected calls are recorded too.
+	auditLogger := audit.NewLogger(healthStorage.AuditLog())
+	verifier, err := auth.NewVerifier(cfg)
+	if err != nil {
+		log.Fatalf("failed to configure authentication: %v", err)
+	}
+	interceptor := auth.NewInterceptor(verifier, auth.NewAuthorizer(healthStorage))
+
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(auditLogger.Unary(), interceptor.Unary()),
+		grpc.ChainStreamInterceptor(auditLogger.Stream(), interceptor.Stream()),
+	)
 
 	// Register gRPC services
-	health.RegisterGeneticDataServiceServer(s, service.NewGeneticDataService(mongoStorage))
-	health.RegisterHealthRecommendationServiceServer(s, service.NewHealthRecommendationService(mongoStorage))
-	health.RegisterLifestyleDataServiceServer(s, service.NewLifestyleDataService(mongoStorage))
-	health.RegisterMedicalRecordServiceServer(s, service.NewMedicalRecordService(mongoStorage))
-	health.RegisterWearableDataServiceServer(s, service.NewWearableDataService(mongoStorage))
-	health.RegisterHealthMonitoringServiceServer(s, service.NewHealthMonitoringService(mongoStorage))
+	health.RegisterGeneticDataServiceServer(s, service.NewGeneticDataService(healthStorage))
+	health.RegisterHealthRecommendationServiceServer(s, service.NewHealthRecommendationService(healthStorage))
+	health.RegisterLifestyleDataServiceServer(s, service.NewLifestyleDataService(healthStorage))
+	health.RegisterMedicalRecordServiceServer(s, service.NewMedicalRecordService(healthStorage))
+	health.RegisterWearableDataServiceServer(s, service.NewWearableDataService(healthStorage))
+	health.RegisterHealthMonitoringServiceServer(s, service.NewHealthMonitoringService(healthStorage))
+	health.RegisterNotificationServiceServer(s, service.NewNotificationService(redisClient))
+	health.RegisterAuditServiceServer(s, service.NewAuditService(healthStorage))
 
 	fmt.Printf("server listening at %v\n", lis.Addr())
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+// newStorage connects to the storage backend selected by STORAGE_BACKEND.
+func newStorage(cfg config.Config) (storage.StorageI, error) {
+	switch cfg.StorageBackend {
+	case "mongo":
+		return mongodb.NewMongoStorage(cfg)
+	case "postgres":
+		return postgres.NewPostgresStorage(cfg)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.StorageBackend)
+	}
+}
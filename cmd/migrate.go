@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/config"
+	mongodb "github.com/health-analytics-service/health-analytics-service/storage/mongo"
+)
+
+// runMigrate lists, applies or reverts the MongoDB data migrations:
+//
+//	myapp migrate status
+//	myapp migrate up [-to N] [-steps N] [-dry-run]
+//	myapp migrate down [-to N] [-steps N] [-dry-run]
+//
+// Pending migrations are also applied when the service starts. Down reverts the
+// latest applied migration unless -to or -steps select more.
+func runMigrate(cfg config.Config, args []string) {
+	if cfg.StorageBackend != "mongo" {
+		log.Fatalf("migrate: not supported by the %s storage backend, whose schema is migrated with the SQL migrations", cfg.StorageBackend)
+	}
+	if len(args) == 0 {
+		log.Fatal("migrate: expected status, up or down")
+	}
+	command := args[0]
+
+	fs := flag.NewFlagSet("migrate "+command, flag.ExitOnError)
+	to := fs.Int64("to", 0, "up: apply migrations up to this version (0 for all); down: revert migrations above this version")
+	steps := fs.Int("steps", 0, "maximum number of migrations to apply or revert (0 for no limit; down defaults to 1 without -to)")
+	dryRun := fs.Bool("dry-run", false, "only list the migrations that would be applied or reverted")
+	fs.Parse(args[1:])
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	db, err := mongodb.Connect(cfg)
+	if err != nil {
+		log.Fatalf("migrate: %v", err)
+	}
+	defer db.Client().Disconnect(context.Background())
+
+	migrator, err := mongodb.NewMigrator(db, mongodb.Migrations())
+	if err != nil {
+		log.Fatalf("migrate: %v", err)
+	}
+
+	opts := mongodb.MigrateOptions{To: *to, Steps: *steps, DryRun: *dryRun}
+	verb := "applied"
+	var migrations []mongodb.Migration
+	switch command {
+	case "status":
+		printMigrationStatus(ctx, migrator)
+		return
+	case "up":
+		migrations, err = migrator.Up(ctx, opts)
+	case "down":
+		if opts.To == 0 && opts.Steps == 0 {
+			opts.Steps = 1
+		}
+		verb = "reverted"
+		migrations, err = migrator.Down(ctx, opts)
+	default:
+		log.Fatalf("migrate: unknown command %q, expected status, up or down", command)
+	}
+
+	if *dryRun {
+		verb = "would have " + verb
+	}
+	for _, migration := range migrations {
+		log.Printf("migrate: %s %d %s", verb, migration.Version, migration.Name)
+	}
+	if err != nil {
+		log.Fatalf("migrate: %v", err)
+	}
+	log.Printf("migrate: %s %d migration(s)", verb, len(migrations))
+}
+
+// printMigrationStatus prints the registered and applied migrations.
+func printMigrationStatus(ctx context.Context, migrator *mongodb.Migrator) {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		log.Fatalf("migrate: %v", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED")
+	for _, status := range statuses {
+		applied := "pending"
+		if !status.AppliedAt.IsZero() {
+			applied = status.AppliedAt.Local().Format(time.RFC3339)
+		}
+		if status.Unknown {
+			applied += " (not registered)"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", status.Version, status.Name, applied)
+	}
+	w.Flush()
+}
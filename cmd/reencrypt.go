@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os/signal"
+	"syscall"
+
+	"github.com/health-analytics-service/health-analytics-service/config"
+	mongodb "github.com/health-analytics-service/health-analytics-service/storage/mongo"
+)
+
+// runReencrypt encrypts plaintext sensitive fields and re-encrypts those encrypted
+// with outdated keys:
+//
+//	myapp reencrypt [-rewrap] [-rotate-data-keys]
+//
+// To rotate the key-encryption key, prepend the new key to ENCRYPTION_KEYS and run
+// with -rewrap; the old key can be removed afterwards. Blind indexes derived from the
+// KEK (no ENCRYPTION_INDEX_KEY) are recomputed by the same run.
+func runReencrypt(cfg config.Config, args []string) {
+	fs := flag.NewFlagSet("reencrypt", flag.ExitOnError)
+	rewrap := fs.Bool("rewrap", false, "re-wrap all data keys with the active key-encryption key")
+	rotate := fs.Bool("rotate-data-keys", false, "create a new data key for every user before re-encrypting")
+	fs.Parse(args)
+
+	if cfg.StorageBackend != "mongo" {
+		log.Fatalf("reencrypt: not supported by the %s storage backend", cfg.StorageBackend)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	stats, err := mongodb.Reencrypt(ctx, cfg, mongodb.ReencryptOptions{Rewrap: *rewrap, RotateDataKeys: *rotate})
+	if err != nil {
+		log.Fatalf("reencrypt: %v", err)
+	}
+	log.Printf("reencrypt: re-wrapped %d and rotated %d data key(s), re-encrypted %d medical record(s) and %d genetic data record(s)",
+		stats.DataKeysRewrapped, stats.DataKeysRotated, stats.MedicalRecords, stats.GeneticData)
+}
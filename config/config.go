@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cast"
@@ -12,6 +13,9 @@ import (
 type Config struct {
 	GRPCPort string
 
+	// Storage backend of the service: "mongo" or "postgres"
+	StorageBackend string
+
 	// PostgreSQL Configuration (Development)
 	PostgresHost     string
 	PostgresPort     int
@@ -39,6 +43,9 @@ type Config struct {
 	RedisPassword string
 	RedisDB       int
 
+	// Notifications expire after this long; zero keeps them forever
+	NotificationTTL time.Duration
+
 	// Kafka Configuration
 	KafkaBrokers                   []string
 	KafkaBrokersTest               []string
@@ -48,6 +55,40 @@ type Config struct {
 	KafkaWearableDataTopic         string
 	KafkaHealthRecommendationTopic string
 
+	// Kafka consumer retries and dead-letter topics
+	KafkaMaxRetries      int
+	KafkaRetryBackoff    time.Duration
+	KafkaMaxRetryBackoff time.Duration
+	KafkaDLQSuffix       string
+
+	// Kafka consumer groups and concurrency
+	KafkaMedicalRecordGroupID        string
+	KafkaGeneticDataGroupID          string
+	KafkaLifestyleDataGroupID        string
+	KafkaWearableDataGroupID         string
+	KafkaHealthRecommendationGroupID string
+	KafkaConsumerWorkers             int
+	KafkaConsumerBatchSize           int
+	KafkaConsumerBatchTimeout        time.Duration
+
+	// JWT authentication: tokens are signed either with an HMAC secret or with an RSA
+	// key whose public half is read from a PEM file
+	JWTSecret        string
+	JWTPublicKeyFile string
+	JWTIssuer        string
+	JWTAudience      string
+
+	// Field encryption: key-encryption keys as comma-separated "id:base64key" pairs,
+	// the first being active, either inline or in a key file (one pair per line)
+	EncryptionKeys     string
+	EncryptionKeyFile  string
+	EncryptionIndexKey string // base64 key of the blind indexes, derived from the active key when empty
+
+	// Soft-deleted data is purged once it has been deleted for this long, checked
+	// every purge interval; zero keeps it forever
+	DeletedRetention       time.Duration
+	RetentionPurgeInterval time.Duration
+
 	LOG_PATH string
 }
 
@@ -60,6 +101,7 @@ func Load() Config {
 	config := Config{}
 
 	config.GRPCPort = cast.ToString(coalesce("GRPC_Port", ":8082"))
+	config.StorageBackend = cast.ToString(coalesce("STORAGE_BACKEND", "mongo"))
 
 	// PostgreSQL Configuration (Development)
 	config.PostgresHost = cast.ToString(coalesce("POSTGRES_HOST", "postgres_dock"))
@@ -79,6 +121,7 @@ func Load() Config {
 	config.RedisAddress = cast.ToString(coalesce("REDIS_ADDRESS", "localhost:6379"))
 	config.RedisPassword = cast.ToString(coalesce("REDIS_PASSWORD", ""))
 	config.RedisDB = cast.ToInt(coalesce("REDIS_DB", 0))
+	config.NotificationTTL = cast.ToDuration(coalesce("NOTIFICATION_TTL", "720h"))
 
 	// MongoDB Configuration
 	config.MongoHost = cast.ToString(coalesce("MONGO_HOST", "mongo"))
@@ -96,6 +139,32 @@ func Load() Config {
 	config.KafkaLifestyleDataTopic = cast.ToString(coalesce("KAFKA_LIFESTYLE_DATA_TOPIC", "lifestyle_data_topic"))
 	config.KafkaWearableDataTopic = cast.ToString(coalesce("KAFKA_WEARABLE_DATA_TOPIC", "wearable_data_topic"))
 	config.KafkaHealthRecommendationTopic = cast.ToString(coalesce("KAFKA_HEALTH_RECOMMENDATION_TOPIC", "health_recommendation_topic"))
+	// Kafka retries and dead-letter topics
+	config.KafkaMaxRetries = cast.ToInt(coalesce("KAFKA_MAX_RETRIES", 5))
+	config.KafkaRetryBackoff = cast.ToDuration(coalesce("KAFKA_RETRY_BACKOFF", "500ms"))
+	config.KafkaMaxRetryBackoff = cast.ToDuration(coalesce("KAFKA_MAX_RETRY_BACKOFF", "30s"))
+	config.KafkaDLQSuffix = cast.ToString(coalesce("KAFKA_DLQ_SUFFIX", ".dlq"))
+	// Kafka consumer groups and concurrency
+	config.KafkaMedicalRecordGroupID = cast.ToString(coalesce("KAFKA_MEDICAL_RECORD_GROUP_ID", "medical-record-group"))
+	config.KafkaGeneticDataGroupID = cast.ToString(coalesce("KAFKA_GENETIC_DATA_GROUP_ID", "genetic-data-group"))
+	config.KafkaLifestyleDataGroupID = cast.ToString(coalesce("KAFKA_LIFESTYLE_DATA_GROUP_ID", "lifestyle-data-group"))
+	config.KafkaWearableDataGroupID = cast.ToString(coalesce("KAFKA_WEARABLE_DATA_GROUP_ID", "wearable-data-group"))
+	config.KafkaHealthRecommendationGroupID = cast.ToString(coalesce("KAFKA_HEALTH_RECOMMENDATION_GROUP_ID", "health-recommendation-group"))
+	config.KafkaConsumerWorkers = cast.ToInt(coalesce("KAFKA_CONSUMER_WORKERS", 8))
+	config.KafkaConsumerBatchSize = cast.ToInt(coalesce("KAFKA_CONSUMER_BATCH_SIZE", 100))
+	config.KafkaConsumerBatchTimeout = cast.ToDuration(coalesce("KAFKA_CONSUMER_BATCH_TIMEOUT", "100ms"))
+	// JWT authentication
+	config.JWTSecret = cast.ToString(coalesce("JWT_SECRET", ""))
+	config.JWTPublicKeyFile = cast.ToString(coalesce("JWT_PUBLIC_KEY_FILE", ""))
+	config.JWTIssuer = cast.ToString(coalesce("JWT_ISSUER", ""))
+	config.JWTAudience = cast.ToString(coalesce("JWT_AUDIENCE", ""))
+	// Field encryption
+	config.EncryptionKeys = cast.ToString(coalesce("ENCRYPTION_KEYS", ""))
+	config.EncryptionKeyFile = cast.ToString(coalesce("ENCRYPTION_KEY_FILE", ""))
+	config.EncryptionIndexKey = cast.ToString(coalesce("ENCRYPTION_INDEX_KEY", ""))
+	// Retention of soft-deleted data
+	config.DeletedRetention = cast.ToDuration(coalesce("DELETED_RETENTION", "720h"))
+	config.RetentionPurgeInterval = cast.ToDuration(coalesce("RETENTION_PURGE_INTERVAL", "1h"))
 	config.LOG_PATH = cast.ToString(coalesce("LOG_PATH", "logs/info.log"))
 
 	return config
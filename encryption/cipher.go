@@ -0,0 +1,358 @@
+package encryption
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// prefix marks encrypted field values. Values without it are legacy plaintext.
+const prefix = "enc:v1:"
+
+// latestTTL bounds how long the latest data key of a user is cached, so that keys
+// rotated by another process are picked up.
+const latestTTL = time.Minute
+
+// DataKey is a versioned data key of a user, wrapped by a KEK.
+type DataKey struct {
+	UserID     string
+	Version    int
+	KEKID      string
+	WrappedKey []byte
+	CreatedAt  time.Time
+}
+
+// KeyStore persists wrapped data keys.
+type KeyStore interface {
+	// LatestDataKey returns the data key of the user with the highest version, or nil.
+	LatestDataKey(ctx context.Context, userID string) (*DataKey, error)
+	// GetDataKey returns a data key of the user by version.
+	GetDataKey(ctx context.Context, userID string, version int) (*DataKey, error)
+	// InsertDataKey stores a new data key, failing with AlreadyExists if its version is taken.
+	InsertDataKey(ctx context.Context, key *DataKey) error
+	// RewrapDataKey replaces the KEK id and wrapped key of a stored data key.
+	RewrapDataKey(ctx context.Context, key *DataKey) error
+	// ForEachDataKey calls fn for every stored data key.
+	ForEachDataKey(ctx context.Context, fn func(*DataKey) error) error
+}
+
+type keyID struct {
+	userID  string
+	version int
+}
+
+type latestKey struct {
+	version  int
+	key      []byte
+	loadedAt time.Time
+}
+
+// Cipher encrypts and decrypts field values with the data keys of their owner.
+// Encrypted values have the form "enc:v1:<base64url owner>:<key version>:<base64 payload>"
+// and are bound to their field and owner, so they cannot be moved to another field
+// or user. Unwrapped data keys are cached in memory.
+type Cipher struct {
+	keyring  *Keyring
+	store    KeyStore
+	indexKey []byte
+
+	mu     sync.Mutex
+	keys   map[keyID][]byte
+	latest map[string]latestKey
+}
+
+// NewCipher creates a Cipher. The blind index key is derived from the active KEK
+// when indexKey is empty.
+func NewCipher(keyring *Keyring, store KeyStore, indexKey []byte) *Cipher {
+	if len(indexKey) == 0 {
+		mac := hmac.New(sha256.New, keyring.keys[keyring.active])
+		mac.Write([]byte("blind-index"))
+		indexKey = mac.Sum(nil)
+	}
+	return &Cipher{
+		keyring:  keyring,
+		store:    store,
+		indexKey: indexKey,
+		keys:     make(map[keyID][]byte),
+		latest:   make(map[string]latestKey),
+	}
+}
+
+// NewCipherFromConfig creates a Cipher from the encryption settings of the configuration.
+func NewCipherFromConfig(cfg config.Config, store KeyStore) (*Cipher, error) {
+	keyring, err := KeyringFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var indexKey []byte
+	if cfg.EncryptionIndexKey != "" {
+		indexKey, err = base64.StdEncoding.DecodeString(cfg.EncryptionIndexKey)
+		if err != nil || len(indexKey) < keySize {
+			return nil, fmt.Errorf("invalid ENCRYPTION_INDEX_KEY: expected at least %d base64-encoded bytes", keySize)
+		}
+	}
+	return NewCipher(keyring, store, indexKey), nil
+}
+
+// IsEncrypted reports whether a stored value is encrypted.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// Encrypt encrypts the value of a field of a user with the user's latest data key,
+// creating the first data key of the user if needed.
+func (c *Cipher) Encrypt(ctx context.Context, userID, field, value string) (string, error) {
+	version, key, err := c.latestKey(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := seal(key, []byte(value), fieldAAD(field, userID))
+	if err != nil {
+		return "", err
+	}
+	owner := base64.RawURLEncoding.EncodeToString([]byte(userID))
+	return prefix + owner + ":" + strconv.Itoa(version) + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a stored field value. Values that are not encrypted are
+// returned unchanged.
+func (c *Cipher) Decrypt(ctx context.Context, field, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	userID, version, sealed, err := parse(value)
+	if err != nil {
+		return "", err
+	}
+	key, err := c.dataKey(ctx, userID, version)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(key, sealed, fieldAAD(field, userID))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt %s: %w", field, err)
+	}
+	return string(plaintext), nil
+}
+
+// NeedsReencryption reports whether a stored value is plaintext, encrypted for
+// another user or with an older data key than the latest of userID.
+func (c *Cipher) NeedsReencryption(ctx context.Context, userID, value string) (bool, error) {
+	if !IsEncrypted(value) {
+		return true, nil
+	}
+	owner, version, _, err := parse(value)
+	if err != nil {
+		return false, err
+	}
+	if owner != userID {
+		return true, nil
+	}
+	latest, _, err := c.latestKey(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return version < latest, nil
+}
+
+// BlindIndex returns a keyed hash of a field value, allowing exact-match queries on
+// encrypted fields. Values are compared ignoring case and surrounding whitespace.
+func (c *Cipher) BlindIndex(field, value string) string {
+	mac := hmac.New(sha256.New, c.indexKey)
+	mac.Write([]byte(field))
+	mac.Write([]byte{0})
+	mac.Write([]byte(strings.ToLower(strings.TrimSpace(value))))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// RotateDataKey creates a new data key for the user, used for all values encrypted
+// from then on. Existing values stay readable with the older keys until re-encrypted.
+func (c *Cipher) RotateDataKey(ctx context.Context, userID string) (int, error) {
+	for {
+		current, err := c.store.LatestDataKey(ctx, userID)
+		if err != nil {
+			return 0, err
+		}
+		version := 1
+		if current != nil {
+			version = current.Version + 1
+		}
+		key, err := c.createDataKey(ctx, userID, version)
+		if status.Code(err) == codes.AlreadyExists {
+			continue // Rotated concurrently, rotate again on top of it
+		}
+		if err != nil {
+			return 0, err
+		}
+		c.mu.Lock()
+		c.latest[userID] = latestKey{version: version, key: key, loadedAt: time.Now()}
+		c.mu.Unlock()
+		return version, nil
+	}
+}
+
+// RewrapDataKeys re-wraps every data key not wrapped by the active KEK with it, so
+// that retired KEKs can be removed from the configuration. It returns the number of
+// keys re-wrapped.
+func (c *Cipher) RewrapDataKeys(ctx context.Context) (int, error) {
+	var rewrapped int
+	err := c.store.ForEachDataKey(ctx, func(key *DataKey) error {
+		if key.KEKID == c.keyring.ActiveID() {
+			return nil
+		}
+		aad := dataKeyAAD(key.UserID, key.Version)
+		plain, err := c.keyring.unwrap(key.KEKID, key.WrappedKey, aad)
+		if err != nil {
+			return fmt.Errorf("failed to unwrap data key %d of user %s: %w", key.Version, key.UserID, err)
+		}
+		key.KEKID, key.WrappedKey, err = c.keyring.wrap(plain, aad)
+		if err != nil {
+			return err
+		}
+		if err := c.store.RewrapDataKey(ctx, key); err != nil {
+			return err
+		}
+		rewrapped++
+		return nil
+	})
+	return rewrapped, err
+}
+
+// latestKey returns the latest data key of the user, creating it if the user has none.
+func (c *Cipher) latestKey(ctx context.Context, userID string) (int, []byte, error) {
+	c.mu.Lock()
+	cached, ok := c.latest[userID]
+	c.mu.Unlock()
+	if ok && time.Since(cached.loadedAt) < latestTTL {
+		return cached.version, cached.key, nil
+	}
+
+	stored, err := c.store.LatestDataKey(ctx, userID)
+	if err != nil {
+		return 0, nil, err
+	}
+	var (
+		version int
+		key     []byte
+	)
+	if stored == nil {
+		version = 1
+		key, err = c.createDataKey(ctx, userID, version)
+		if status.Code(err) == codes.AlreadyExists {
+			// Created concurrently
+			return c.latestKey(ctx, userID)
+		}
+	} else {
+		version = stored.Version
+		key, err = c.unwrapDataKey(stored)
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	c.mu.Lock()
+	c.latest[userID] = latestKey{version: version, key: key, loadedAt: time.Now()}
+	c.keys[keyID{userID, version}] = key
+	c.mu.Unlock()
+	return version, key, nil
+}
+
+// dataKey returns a data key of the user by version.
+func (c *Cipher) dataKey(ctx context.Context, userID string, version int) ([]byte, error) {
+	c.mu.Lock()
+	key, ok := c.keys[keyID{userID, version}]
+	c.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	stored, err := c.store.GetDataKey(ctx, userID, version)
+	if err != nil {
+		return nil, err
+	}
+	key, err = c.unwrapDataKey(stored)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.keys[keyID{userID, version}] = key
+	c.mu.Unlock()
+	return key, nil
+}
+
+// createDataKey generates, wraps and stores a new data key.
+func (c *Cipher) createDataKey(ctx context.Context, userID string, version int) ([]byte, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	kekID, wrapped, err := c.keyring.wrap(key, dataKeyAAD(userID, version))
+	if err != nil {
+		return nil, err
+	}
+	if err := c.store.InsertDataKey(ctx, &DataKey{
+		UserID:     userID,
+		Version:    version,
+		KEKID:      kekID,
+		WrappedKey: wrapped,
+		CreatedAt:  time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.keys[keyID{userID, version}] = key
+	c.mu.Unlock()
+	return key, nil
+}
+
+func (c *Cipher) unwrapDataKey(stored *DataKey) ([]byte, error) {
+	key, err := c.keyring.unwrap(stored.KEKID, stored.WrappedKey, dataKeyAAD(stored.UserID, stored.Version))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key %d of user %s: %w", stored.Version, stored.UserID, err)
+	}
+	return key, nil
+}
+
+// parse splits an encrypted value into its owner, key version and sealed payload.
+func parse(value string) (string, int, []byte, error) {
+	parts := strings.SplitN(strings.TrimPrefix(value, prefix), ":", 3)
+	if len(parts) != 3 {
+		return "", 0, nil, errors.New("malformed encrypted value")
+	}
+	owner, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", 0, nil, errors.New("malformed encrypted value owner")
+	}
+	version, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", 0, nil, errors.New("malformed encrypted value key version")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", 0, nil, errors.New("malformed encrypted value payload")
+	}
+	return string(owner), version, sealed, nil
+}
+
+func fieldAAD(field, userID string) []byte {
+	return []byte("field\x00" + field + "\x00" + userID)
+}
+
+func dataKeyAAD(userID string, version int) []byte {
+	return []byte("data-key\x00" + userID + "\x00" + strconv.Itoa(version))
+}
@@ -0,0 +1,133 @@
+// Package encryption implements envelope encryption of sensitive fields: every user
+// has versioned AES-256 data keys, which are stored wrapped (AES-GCM encrypted) by a
+// key-encryption key (KEK) held only in the configuration.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/health-analytics-service/health-analytics-service/config"
+)
+
+// keySize is the size of KEKs and data keys (AES-256).
+const keySize = 32
+
+// Keyring holds the key-encryption keys. The active key wraps new data keys; the
+// others are only used to unwrap data keys wrapped before a rotation.
+type Keyring struct {
+	active string
+	keys   map[string][]byte
+}
+
+// ParseKeyring parses comma- or newline-separated "id:base64key" pairs. The first
+// pair is the active key.
+func ParseKeyring(spec string) (*Keyring, error) {
+	k := &Keyring{keys: make(map[string][]byte)}
+	for _, entry := range strings.FieldsFunc(spec, func(r rune) bool { return r == ',' || r == '\n' }) {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+		id, encoded, ok := strings.Cut(entry, ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid encryption key %q: expected id:base64key", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil || len(key) != keySize {
+			return nil, fmt.Errorf("invalid encryption key %q: expected %d base64-encoded bytes", id, keySize)
+		}
+		if _, ok := k.keys[id]; ok {
+			return nil, fmt.Errorf("duplicate encryption key %q", id)
+		}
+		if k.active == "" {
+			k.active = id
+		}
+		k.keys[id] = key
+	}
+	if k.active == "" {
+		return nil, errors.New("no encryption key configured")
+	}
+	return k, nil
+}
+
+// KeyringFromConfig loads the keyring from ENCRYPTION_KEYS or ENCRYPTION_KEY_FILE.
+func KeyringFromConfig(cfg config.Config) (*Keyring, error) {
+	switch {
+	case cfg.EncryptionKeys != "" && cfg.EncryptionKeyFile != "":
+		return nil, errors.New("ENCRYPTION_KEYS and ENCRYPTION_KEY_FILE are mutually exclusive")
+	case cfg.EncryptionKeys != "":
+		return ParseKeyring(cfg.EncryptionKeys)
+	case cfg.EncryptionKeyFile != "":
+		spec, err := os.ReadFile(cfg.EncryptionKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read encryption key file: %w", err)
+		}
+		return ParseKeyring(string(spec))
+	default:
+		return nil, errors.New("either ENCRYPTION_KEYS or ENCRYPTION_KEY_FILE must be set")
+	}
+}
+
+// ActiveID returns the id of the active key.
+func (k *Keyring) ActiveID() string {
+	return k.active
+}
+
+// wrap encrypts a data key with the active KEK.
+func (k *Keyring) wrap(dataKey, aad []byte) (string, []byte, error) {
+	sealed, err := seal(k.keys[k.active], dataKey, aad)
+	if err != nil {
+		return "", nil, err
+	}
+	return k.active, sealed, nil
+}
+
+// unwrap decrypts a data key wrapped by the given KEK.
+func (k *Keyring) unwrap(kekID string, wrapped, aad []byte) ([]byte, error) {
+	kek, ok := k.keys[kekID]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption key %q", kekID)
+	}
+	return open(kek, wrapped, aad)
+}
+
+// seal encrypts plaintext with AES-GCM, prefixing the random nonce.
+func seal(key, plaintext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(plaintext)+gcm.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+// open decrypts the output of seal.
+func open(key, sealed, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, aad)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,193 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/health-analytics-service/health-analytics-service/encryption"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	oldKeys = "old:MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	newKeys = "new:ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA=," + oldKeys
+)
+
+// memoryKeyStore is an in-memory encryption.KeyStore.
+type memoryKeyStore struct {
+	mu   sync.Mutex
+	keys map[string]encryption.DataKey
+}
+
+func newMemoryKeyStore() *memoryKeyStore {
+	return &memoryKeyStore{keys: make(map[string]encryption.DataKey)}
+}
+
+func (s *memoryKeyStore) LatestDataKey(ctx context.Context, userID string) (*encryption.DataKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var latest *encryption.DataKey
+	for _, key := range s.keys {
+		if key.UserID == userID && (latest == nil || key.Version > latest.Version) {
+			key := key
+			latest = &key
+		}
+	}
+	return latest, nil
+}
+
+func (s *memoryKeyStore) GetDataKey(ctx context.Context, userID string, version int) (*encryption.DataKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key, ok := s.keys[fmt.Sprintf("%s:%d", userID, version)]
+	if !ok {
+		return nil, fmt.Errorf("data key %d of %s not found", version, userID)
+	}
+	return &key, nil
+}
+
+func (s *memoryKeyStore) InsertDataKey(ctx context.Context, key *encryption.DataKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[fmt.Sprintf("%s:%d", key.UserID, key.Version)] = *key
+	return nil
+}
+
+func (s *memoryKeyStore) RewrapDataKey(ctx context.Context, key *encryption.DataKey) error {
+	return s.InsertDataKey(ctx, key)
+}
+
+func (s *memoryKeyStore) ForEachDataKey(ctx context.Context, fn func(*encryption.DataKey) error) error {
+	s.mu.Lock()
+	keys := make([]encryption.DataKey, 0, len(s.keys))
+	for _, key := range s.keys {
+		keys = append(keys, key)
+	}
+	s.mu.Unlock()
+	for i := range keys {
+		if err := fn(&keys[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newCipher(t *testing.T, spec string, store encryption.KeyStore) *encryption.Cipher {
+	keyring, err := encryption.ParseKeyring(spec)
+	if err != nil {
+		t.Fatalf("failed to parse keyring: %v", err)
+	}
+	return encryption.NewCipher(keyring, store, nil)
+}
+
+func TestParseKeyring(t *testing.T) {
+	keyring, err := encryption.ParseKeyring(newKeys)
+	assert.NoError(t, err)
+	assert.Equal(t, "new", keyring.ActiveID())
+
+	_, err = encryption.ParseKeyring("")
+	assert.Error(t, err)
+	_, err = encryption.ParseKeyring("short:c2hvcnQ=")
+	assert.Error(t, err)
+	_, err = encryption.ParseKeyring(oldKeys + "," + oldKeys)
+	assert.Error(t, err)
+}
+
+func TestCipher(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		cipher := newCipher(t, oldKeys, newMemoryKeyStore())
+		encrypted, err := cipher.Encrypt(ctx, "user-1", "description", "Type 2 diabetes")
+		assert.NoError(t, err)
+		assert.True(t, encryption.IsEncrypted(encrypted))
+		assert.NotContains(t, encrypted, "diabetes")
+
+		decrypted, err := cipher.Decrypt(ctx, "description", encrypted)
+		assert.NoError(t, err)
+		assert.Equal(t, "Type 2 diabetes", decrypted)
+	})
+
+	t.Run("Plaintext", func(t *testing.T) {
+		cipher := newCipher(t, oldKeys, newMemoryKeyStore())
+		decrypted, err := cipher.Decrypt(ctx, "description", "legacy value")
+		assert.NoError(t, err)
+		assert.Equal(t, "legacy value", decrypted)
+
+		stale, err := cipher.NeedsReencryption(ctx, "user-1", "legacy value")
+		assert.NoError(t, err)
+		assert.True(t, stale)
+	})
+
+	t.Run("BoundToField", func(t *testing.T) {
+		cipher := newCipher(t, oldKeys, newMemoryKeyStore())
+		encrypted, err := cipher.Encrypt(ctx, "user-1", "description", "secret")
+		assert.NoError(t, err)
+
+		_, err = cipher.Decrypt(ctx, "attachments", encrypted)
+		assert.Error(t, err)
+
+		tampered := encrypted[:len(encrypted)-4] + "AAA="
+		_, err = cipher.Decrypt(ctx, "description", tampered)
+		assert.Error(t, err)
+	})
+
+	t.Run("RotateDataKey", func(t *testing.T) {
+		cipher := newCipher(t, oldKeys, newMemoryKeyStore())
+		before, err := cipher.Encrypt(ctx, "user-1", "description", "secret")
+		assert.NoError(t, err)
+
+		version, err := cipher.RotateDataKey(ctx, "user-1")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, version)
+
+		stale, err := cipher.NeedsReencryption(ctx, "user-1", before)
+		assert.NoError(t, err)
+		assert.True(t, stale)
+
+		after, err := cipher.Encrypt(ctx, "user-1", "description", "secret")
+		assert.NoError(t, err)
+		stale, err = cipher.NeedsReencryption(ctx, "user-1", after)
+		assert.NoError(t, err)
+		assert.False(t, stale)
+
+		// Values encrypted with the previous key stay readable
+		decrypted, err := cipher.Decrypt(ctx, "description", before)
+		assert.NoError(t, err)
+		assert.Equal(t, "secret", decrypted)
+
+		stale, err = cipher.NeedsReencryption(ctx, "user-2", after)
+		assert.NoError(t, err)
+		assert.True(t, stale)
+	})
+
+	t.Run("RewrapDataKeys", func(t *testing.T) {
+		store := newMemoryKeyStore()
+		encrypted, err := newCipher(t, oldKeys, store).Encrypt(ctx, "user-1", "description", "secret")
+		assert.NoError(t, err)
+
+		rotated := newCipher(t, newKeys, store)
+		rewrapped, err := rotated.RewrapDataKeys(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, rewrapped)
+
+		// The old KEK is no longer needed once the data keys are re-wrapped
+		retired := newCipher(t, strings.Split(newKeys, ",")[0], store)
+		decrypted, err := retired.Decrypt(ctx, "description", encrypted)
+		assert.NoError(t, err)
+		assert.Equal(t, "secret", decrypted)
+
+		rewrapped, err = rotated.RewrapDataKeys(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, rewrapped)
+	})
+
+	t.Run("BlindIndex", func(t *testing.T) {
+		cipher := newCipher(t, oldKeys, newMemoryKeyStore())
+		assert.Equal(t, cipher.BlindIndex("description", "Asthma"), cipher.BlindIndex("description", " asthma "))
+		assert.NotEqual(t, cipher.BlindIndex("description", "Asthma"), cipher.BlindIndex("attachments", "Asthma"))
+	})
+}
@@ -0,0 +1,169 @@
+// Package errs maps storage and validation failures to gRPC status errors carrying
+// google.rpc error details, so that clients get a meaningful code instead of Unknown.
+package errs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// unavailableRetryDelay is suggested to clients when the database is unreachable.
+const unavailableRetryDelay = time.Second
+
+// errorDomain identifies this service in ErrorInfo details.
+const errorDomain = "health-analytics-service"
+
+// withDetails attaches error details to a status, falling back to the bare status
+// if they cannot be encoded.
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	if detailed, err := st.WithDetails(details...); err == nil {
+		return detailed.Err()
+	}
+	return st.Err()
+}
+
+// InvalidArgument reports an invalid request field, with a BadRequest field violation.
+func InvalidArgument(field, format string, args ...interface{}) error {
+	description := fmt.Sprintf(format, args...)
+	return withDetails(status.New(codes.InvalidArgument, description), &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	})
+}
+
+// FieldViolation describes one invalid request field.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// InvalidFields reports several invalid request fields at once, with one BadRequest
+// field violation each.
+func InvalidFields(violations ...FieldViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	details := &errdetails.BadRequest{}
+	descriptions := make([]string, len(violations))
+	for i, v := range violations {
+		details.FieldViolations = append(details.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+		descriptions[i] = v.Description
+	}
+	return withDetails(status.New(codes.InvalidArgument, strings.Join(descriptions, "; ")), details)
+}
+
+// NotFound reports a missing resource, e.g. NotFound("genetic data", id), with its ResourceInfo.
+func NotFound(resource, id string) error {
+	return withDetails(status.Newf(codes.NotFound, "%s not found", resource), &errdetails.ResourceInfo{
+		ResourceType: resource,
+		ResourceName: id,
+		Description:  fmt.Sprintf("%s %s does not exist", resource, id),
+	})
+}
+
+// AlreadyExists reports a resource whose id is taken, with its ResourceInfo.
+func AlreadyExists(resource, id string) error {
+	return withDetails(status.Newf(codes.AlreadyExists, "%s already exists", resource), &errdetails.ResourceInfo{
+		ResourceType: resource,
+		ResourceName: id,
+		Description:  fmt.Sprintf("%s %s already exists", resource, id),
+	})
+}
+
+// FailedPrecondition reports a request that cannot be applied in the current state.
+func FailedPrecondition(subject, format string, args ...interface{}) error {
+	description := fmt.Sprintf(format, args...)
+	return withDetails(status.New(codes.FailedPrecondition, description), &errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{{Type: "STATE", Subject: subject, Description: description}},
+	})
+}
+
+// Aborted reports a write that kept conflicting with concurrent modifications, with an
+// ErrorInfo carrying a machine-readable reason such as "CONCURRENT_MODIFICATION".
+// Clients may retry the whole read-modify-write sequence.
+func Aborted(reason, format string, args ...interface{}) error {
+	return withDetails(status.Newf(codes.Aborted, format, args...), &errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: errorDomain,
+	})
+}
+
+// Unauthenticated reports a request without valid credentials.
+func Unauthenticated(format string, args ...interface{}) error {
+	return status.Errorf(codes.Unauthenticated, format, args...)
+}
+
+// PermissionDenied reports a caller that may not perform a request, with an ErrorInfo
+// carrying a machine-readable reason such as "NOT_OWNER".
+func PermissionDenied(reason, format string, args ...interface{}) error {
+	return withDetails(status.Newf(codes.PermissionDenied, format, args...), &errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: errorDomain,
+	})
+}
+
+// Wrap prefixes err with a message, keeping the gRPC code and details of status errors.
+// Other errors are classified: context and MongoDB timeouts become DeadlineExceeded,
+// MongoDB and network connectivity failures Unavailable, duplicate keys AlreadyExists and anything
+// else Internal.
+func Wrap(err error, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	p := classify(err).Proto()
+	p.Message = fmt.Sprintf(format, args...) + ": " + err.Error()
+	return status.ErrorProto(p)
+}
+
+// Code returns the gRPC code Wrap would give err.
+func Code(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	return classify(err).Code()
+}
+
+// classify returns the status describing err.
+func classify(err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	var (
+		serverSelection topology.ServerSelectionError
+		netErr          net.Error
+	)
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded), mongo.IsTimeout(err):
+		return status.New(codes.DeadlineExceeded, err.Error())
+	case mongo.IsDuplicateKeyError(err):
+		return status.New(codes.AlreadyExists, err.Error())
+	case mongo.IsNetworkError(err), errors.As(err, &serverSelection), errors.Is(err, mongo.ErrClientDisconnected),
+		errors.As(err, &netErr):
+		st, detailsErr := status.New(codes.Unavailable, err.Error()).WithDetails(&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(unavailableRetryDelay),
+		})
+		if detailsErr != nil {
+			return status.New(codes.Unavailable, err.Error())
+		}
+		return st
+	default:
+		return status.New(codes.Internal, err.Error())
+	}
+}
@@ -164,11 +164,14 @@ var HealthMonitoringService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	MedicalRecordService_CreateMedicalRecord_FullMethodName = "/health.MedicalRecordService/CreateMedicalRecord"
-	MedicalRecordService_GetMedicalRecord_FullMethodName    = "/health.MedicalRecordService/GetMedicalRecord"
-	MedicalRecordService_UpdateMedicalRecord_FullMethodName = "/health.MedicalRecordService/UpdateMedicalRecord"
-	MedicalRecordService_DeleteMedicalRecord_FullMethodName = "/health.MedicalRecordService/DeleteMedicalRecord"
-	MedicalRecordService_ListMedicalRecords_FullMethodName  = "/health.MedicalRecordService/ListMedicalRecords"
+	MedicalRecordService_CreateMedicalRecord_FullMethodName       = "/health.MedicalRecordService/CreateMedicalRecord"
+	MedicalRecordService_GetMedicalRecord_FullMethodName          = "/health.MedicalRecordService/GetMedicalRecord"
+	MedicalRecordService_UpdateMedicalRecord_FullMethodName       = "/health.MedicalRecordService/UpdateMedicalRecord"
+	MedicalRecordService_DeleteMedicalRecord_FullMethodName       = "/health.MedicalRecordService/DeleteMedicalRecord"
+	MedicalRecordService_RestoreMedicalRecord_FullMethodName      = "/health.MedicalRecordService/RestoreMedicalRecord"
+	MedicalRecordService_ListMedicalRecords_FullMethodName        = "/health.MedicalRecordService/ListMedicalRecords"
+	MedicalRecordService_ListMedicalRecordVersions_FullMethodName = "/health.MedicalRecordService/ListMedicalRecordVersions"
+	MedicalRecordService_GetMedicalRecordVersion_FullMethodName   = "/health.MedicalRecordService/GetMedicalRecordVersion"
 )
 
 // MedicalRecordServiceClient is the client API for MedicalRecordService service.
@@ -177,11 +180,16 @@ const (
 //
 // Services
 type MedicalRecordServiceClient interface {
-	CreateMedicalRecord(ctx context.Context, in *MedicalRecord, opts ...grpc.CallOption) (*Empty, error)
+	CreateMedicalRecord(ctx context.Context, in *MedicalRecord, opts ...grpc.CallOption) (*MedicalRecord, error)
 	GetMedicalRecord(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*MedicalRecord, error)
-	UpdateMedicalRecord(ctx context.Context, in *MedicalRecord, opts ...grpc.CallOption) (*Empty, error)
+	UpdateMedicalRecord(ctx context.Context, in *MedicalRecord, opts ...grpc.CallOption) (*MedicalRecord, error)
 	DeleteMedicalRecord(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Restores a deleted medical record until it is purged by the retention job
+	RestoreMedicalRecord(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*MedicalRecord, error)
 	ListMedicalRecords(ctx context.Context, in *ListMedicalRecordsRequest, opts ...grpc.CallOption) (*ListMedicalRecordsResponse, error)
+	// Lists the prior revisions of a medical record; the current one is returned by GetMedicalRecord
+	ListMedicalRecordVersions(ctx context.Context, in *ListMedicalRecordVersionsRequest, opts ...grpc.CallOption) (*ListMedicalRecordVersionsResponse, error)
+	GetMedicalRecordVersion(ctx context.Context, in *GetMedicalRecordVersionRequest, opts ...grpc.CallOption) (*MedicalRecord, error)
 }
 
 type medicalRecordServiceClient struct {
@@ -192,9 +200,9 @@ func NewMedicalRecordServiceClient(cc grpc.ClientConnInterface) MedicalRecordSer
 	return &medicalRecordServiceClient{cc}
 }
 
-func (c *medicalRecordServiceClient) CreateMedicalRecord(ctx context.Context, in *MedicalRecord, opts ...grpc.CallOption) (*Empty, error) {
+func (c *medicalRecordServiceClient) CreateMedicalRecord(ctx context.Context, in *MedicalRecord, opts ...grpc.CallOption) (*MedicalRecord, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
+	out := new(MedicalRecord)
 	err := c.cc.Invoke(ctx, MedicalRecordService_CreateMedicalRecord_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -212,9 +220,9 @@ func (c *medicalRecordServiceClient) GetMedicalRecord(ctx context.Context, in *B
 	return out, nil
 }
 
-func (c *medicalRecordServiceClient) UpdateMedicalRecord(ctx context.Context, in *MedicalRecord, opts ...grpc.CallOption) (*Empty, error) {
+func (c *medicalRecordServiceClient) UpdateMedicalRecord(ctx context.Context, in *MedicalRecord, opts ...grpc.CallOption) (*MedicalRecord, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
+	out := new(MedicalRecord)
 	err := c.cc.Invoke(ctx, MedicalRecordService_UpdateMedicalRecord_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -232,6 +240,16 @@ func (c *medicalRecordServiceClient) DeleteMedicalRecord(ctx context.Context, in
 	return out, nil
 }
 
+func (c *medicalRecordServiceClient) RestoreMedicalRecord(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*MedicalRecord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MedicalRecord)
+	err := c.cc.Invoke(ctx, MedicalRecordService_RestoreMedicalRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *medicalRecordServiceClient) ListMedicalRecords(ctx context.Context, in *ListMedicalRecordsRequest, opts ...grpc.CallOption) (*ListMedicalRecordsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMedicalRecordsResponse)
@@ -242,17 +260,42 @@ func (c *medicalRecordServiceClient) ListMedicalRecords(ctx context.Context, in
 	return out, nil
 }
 
+func (c *medicalRecordServiceClient) ListMedicalRecordVersions(ctx context.Context, in *ListMedicalRecordVersionsRequest, opts ...grpc.CallOption) (*ListMedicalRecordVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMedicalRecordVersionsResponse)
+	err := c.cc.Invoke(ctx, MedicalRecordService_ListMedicalRecordVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *medicalRecordServiceClient) GetMedicalRecordVersion(ctx context.Context, in *GetMedicalRecordVersionRequest, opts ...grpc.CallOption) (*MedicalRecord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MedicalRecord)
+	err := c.cc.Invoke(ctx, MedicalRecordService_GetMedicalRecordVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MedicalRecordServiceServer is the server API for MedicalRecordService service.
 // All implementations must embed UnimplementedMedicalRecordServiceServer
 // for forward compatibility.
 //
 // Services
 type MedicalRecordServiceServer interface {
-	CreateMedicalRecord(context.Context, *MedicalRecord) (*Empty, error)
+	CreateMedicalRecord(context.Context, *MedicalRecord) (*MedicalRecord, error)
 	GetMedicalRecord(context.Context, *ByIdRequest) (*MedicalRecord, error)
-	UpdateMedicalRecord(context.Context, *MedicalRecord) (*Empty, error)
+	UpdateMedicalRecord(context.Context, *MedicalRecord) (*MedicalRecord, error)
 	DeleteMedicalRecord(context.Context, *ByIdRequest) (*Empty, error)
+	// Restores a deleted medical record until it is purged by the retention job
+	RestoreMedicalRecord(context.Context, *ByIdRequest) (*MedicalRecord, error)
 	ListMedicalRecords(context.Context, *ListMedicalRecordsRequest) (*ListMedicalRecordsResponse, error)
+	// Lists the prior revisions of a medical record; the current one is returned by GetMedicalRecord
+	ListMedicalRecordVersions(context.Context, *ListMedicalRecordVersionsRequest) (*ListMedicalRecordVersionsResponse, error)
+	GetMedicalRecordVersion(context.Context, *GetMedicalRecordVersionRequest) (*MedicalRecord, error)
 	mustEmbedUnimplementedMedicalRecordServiceServer()
 }
 
@@ -263,21 +306,30 @@ type MedicalRecordServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedMedicalRecordServiceServer struct{}
 
-func (UnimplementedMedicalRecordServiceServer) CreateMedicalRecord(context.Context, *MedicalRecord) (*Empty, error) {
+func (UnimplementedMedicalRecordServiceServer) CreateMedicalRecord(context.Context, *MedicalRecord) (*MedicalRecord, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMedicalRecord not implemented")
 }
 func (UnimplementedMedicalRecordServiceServer) GetMedicalRecord(context.Context, *ByIdRequest) (*MedicalRecord, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMedicalRecord not implemented")
 }
-func (UnimplementedMedicalRecordServiceServer) UpdateMedicalRecord(context.Context, *MedicalRecord) (*Empty, error) {
+func (UnimplementedMedicalRecordServiceServer) UpdateMedicalRecord(context.Context, *MedicalRecord) (*MedicalRecord, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMedicalRecord not implemented")
 }
 func (UnimplementedMedicalRecordServiceServer) DeleteMedicalRecord(context.Context, *ByIdRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMedicalRecord not implemented")
 }
+func (UnimplementedMedicalRecordServiceServer) RestoreMedicalRecord(context.Context, *ByIdRequest) (*MedicalRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMedicalRecord not implemented")
+}
 func (UnimplementedMedicalRecordServiceServer) ListMedicalRecords(context.Context, *ListMedicalRecordsRequest) (*ListMedicalRecordsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMedicalRecords not implemented")
 }
+func (UnimplementedMedicalRecordServiceServer) ListMedicalRecordVersions(context.Context, *ListMedicalRecordVersionsRequest) (*ListMedicalRecordVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMedicalRecordVersions not implemented")
+}
+func (UnimplementedMedicalRecordServiceServer) GetMedicalRecordVersion(context.Context, *GetMedicalRecordVersionRequest) (*MedicalRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMedicalRecordVersion not implemented")
+}
 func (UnimplementedMedicalRecordServiceServer) mustEmbedUnimplementedMedicalRecordServiceServer() {}
 func (UnimplementedMedicalRecordServiceServer) testEmbeddedByValue()                              {}
 
@@ -371,6 +423,24 @@ func _MedicalRecordService_DeleteMedicalRecord_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MedicalRecordService_RestoreMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MedicalRecordServiceServer).RestoreMedicalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MedicalRecordService_RestoreMedicalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MedicalRecordServiceServer).RestoreMedicalRecord(ctx, req.(*ByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MedicalRecordService_ListMedicalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListMedicalRecordsRequest)
 	if err := dec(in); err != nil {
@@ -389,6 +459,42 @@ func _MedicalRecordService_ListMedicalRecords_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MedicalRecordService_ListMedicalRecordVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMedicalRecordVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MedicalRecordServiceServer).ListMedicalRecordVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MedicalRecordService_ListMedicalRecordVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MedicalRecordServiceServer).ListMedicalRecordVersions(ctx, req.(*ListMedicalRecordVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MedicalRecordService_GetMedicalRecordVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMedicalRecordVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MedicalRecordServiceServer).GetMedicalRecordVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MedicalRecordService_GetMedicalRecordVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MedicalRecordServiceServer).GetMedicalRecordVersion(ctx, req.(*GetMedicalRecordVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MedicalRecordService_ServiceDesc is the grpc.ServiceDesc for MedicalRecordService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -412,31 +518,46 @@ var MedicalRecordService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMedicalRecord",
 			Handler:    _MedicalRecordService_DeleteMedicalRecord_Handler,
 		},
+		{
+			MethodName: "RestoreMedicalRecord",
+			Handler:    _MedicalRecordService_RestoreMedicalRecord_Handler,
+		},
 		{
 			MethodName: "ListMedicalRecords",
 			Handler:    _MedicalRecordService_ListMedicalRecords_Handler,
 		},
+		{
+			MethodName: "ListMedicalRecordVersions",
+			Handler:    _MedicalRecordService_ListMedicalRecordVersions_Handler,
+		},
+		{
+			MethodName: "GetMedicalRecordVersion",
+			Handler:    _MedicalRecordService_GetMedicalRecordVersion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/medical.proto",
 }
 
 const (
-	GeneticDataService_CreateGeneticData_FullMethodName = "/health.GeneticDataService/CreateGeneticData"
-	GeneticDataService_GetGeneticData_FullMethodName    = "/health.GeneticDataService/GetGeneticData"
-	GeneticDataService_UpdateGeneticData_FullMethodName = "/health.GeneticDataService/UpdateGeneticData"
-	GeneticDataService_DeleteGeneticData_FullMethodName = "/health.GeneticDataService/DeleteGeneticData"
-	GeneticDataService_ListGeneticData_FullMethodName   = "/health.GeneticDataService/ListGeneticData"
+	GeneticDataService_CreateGeneticData_FullMethodName  = "/health.GeneticDataService/CreateGeneticData"
+	GeneticDataService_GetGeneticData_FullMethodName     = "/health.GeneticDataService/GetGeneticData"
+	GeneticDataService_UpdateGeneticData_FullMethodName  = "/health.GeneticDataService/UpdateGeneticData"
+	GeneticDataService_DeleteGeneticData_FullMethodName  = "/health.GeneticDataService/DeleteGeneticData"
+	GeneticDataService_RestoreGeneticData_FullMethodName = "/health.GeneticDataService/RestoreGeneticData"
+	GeneticDataService_ListGeneticData_FullMethodName    = "/health.GeneticDataService/ListGeneticData"
 )
 
 // GeneticDataServiceClient is the client API for GeneticDataService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GeneticDataServiceClient interface {
-	CreateGeneticData(ctx context.Context, in *GeneticData, opts ...grpc.CallOption) (*Empty, error)
+	CreateGeneticData(ctx context.Context, in *GeneticData, opts ...grpc.CallOption) (*GeneticData, error)
 	GetGeneticData(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*GeneticData, error)
-	UpdateGeneticData(ctx context.Context, in *GeneticData, opts ...grpc.CallOption) (*Empty, error)
+	UpdateGeneticData(ctx context.Context, in *GeneticData, opts ...grpc.CallOption) (*GeneticData, error)
 	DeleteGeneticData(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Restores a deleted genetic data record until it is purged by the retention job
+	RestoreGeneticData(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*GeneticData, error)
 	ListGeneticData(ctx context.Context, in *ListGeneticDataRequest, opts ...grpc.CallOption) (*ListGeneticDataResponse, error)
 }
 
@@ -448,9 +569,9 @@ func NewGeneticDataServiceClient(cc grpc.ClientConnInterface) GeneticDataService
 	return &geneticDataServiceClient{cc}
 }
 
-func (c *geneticDataServiceClient) CreateGeneticData(ctx context.Context, in *GeneticData, opts ...grpc.CallOption) (*Empty, error) {
+func (c *geneticDataServiceClient) CreateGeneticData(ctx context.Context, in *GeneticData, opts ...grpc.CallOption) (*GeneticData, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
+	out := new(GeneticData)
 	err := c.cc.Invoke(ctx, GeneticDataService_CreateGeneticData_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -468,9 +589,9 @@ func (c *geneticDataServiceClient) GetGeneticData(ctx context.Context, in *ByIdR
 	return out, nil
 }
 
-func (c *geneticDataServiceClient) UpdateGeneticData(ctx context.Context, in *GeneticData, opts ...grpc.CallOption) (*Empty, error) {
+func (c *geneticDataServiceClient) UpdateGeneticData(ctx context.Context, in *GeneticData, opts ...grpc.CallOption) (*GeneticData, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
+	out := new(GeneticData)
 	err := c.cc.Invoke(ctx, GeneticDataService_UpdateGeneticData_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -488,6 +609,16 @@ func (c *geneticDataServiceClient) DeleteGeneticData(ctx context.Context, in *By
 	return out, nil
 }
 
+func (c *geneticDataServiceClient) RestoreGeneticData(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*GeneticData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneticData)
+	err := c.cc.Invoke(ctx, GeneticDataService_RestoreGeneticData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *geneticDataServiceClient) ListGeneticData(ctx context.Context, in *ListGeneticDataRequest, opts ...grpc.CallOption) (*ListGeneticDataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListGeneticDataResponse)
@@ -502,10 +633,12 @@ func (c *geneticDataServiceClient) ListGeneticData(ctx context.Context, in *List
 // All implementations must embed UnimplementedGeneticDataServiceServer
 // for forward compatibility.
 type GeneticDataServiceServer interface {
-	CreateGeneticData(context.Context, *GeneticData) (*Empty, error)
+	CreateGeneticData(context.Context, *GeneticData) (*GeneticData, error)
 	GetGeneticData(context.Context, *ByIdRequest) (*GeneticData, error)
-	UpdateGeneticData(context.Context, *GeneticData) (*Empty, error)
+	UpdateGeneticData(context.Context, *GeneticData) (*GeneticData, error)
 	DeleteGeneticData(context.Context, *ByIdRequest) (*Empty, error)
+	// Restores a deleted genetic data record until it is purged by the retention job
+	RestoreGeneticData(context.Context, *ByIdRequest) (*GeneticData, error)
 	ListGeneticData(context.Context, *ListGeneticDataRequest) (*ListGeneticDataResponse, error)
 	mustEmbedUnimplementedGeneticDataServiceServer()
 }
@@ -517,18 +650,21 @@ type GeneticDataServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedGeneticDataServiceServer struct{}
 
-func (UnimplementedGeneticDataServiceServer) CreateGeneticData(context.Context, *GeneticData) (*Empty, error) {
+func (UnimplementedGeneticDataServiceServer) CreateGeneticData(context.Context, *GeneticData) (*GeneticData, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGeneticData not implemented")
 }
 func (UnimplementedGeneticDataServiceServer) GetGeneticData(context.Context, *ByIdRequest) (*GeneticData, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGeneticData not implemented")
 }
-func (UnimplementedGeneticDataServiceServer) UpdateGeneticData(context.Context, *GeneticData) (*Empty, error) {
+func (UnimplementedGeneticDataServiceServer) UpdateGeneticData(context.Context, *GeneticData) (*GeneticData, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGeneticData not implemented")
 }
 func (UnimplementedGeneticDataServiceServer) DeleteGeneticData(context.Context, *ByIdRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGeneticData not implemented")
 }
+func (UnimplementedGeneticDataServiceServer) RestoreGeneticData(context.Context, *ByIdRequest) (*GeneticData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreGeneticData not implemented")
+}
 func (UnimplementedGeneticDataServiceServer) ListGeneticData(context.Context, *ListGeneticDataRequest) (*ListGeneticDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGeneticData not implemented")
 }
@@ -625,6 +761,24 @@ func _GeneticDataService_DeleteGeneticData_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GeneticDataService_RestoreGeneticData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeneticDataServiceServer).RestoreGeneticData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeneticDataService_RestoreGeneticData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeneticDataServiceServer).RestoreGeneticData(ctx, req.(*ByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GeneticDataService_ListGeneticData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListGeneticDataRequest)
 	if err := dec(in); err != nil {
@@ -666,6 +820,10 @@ var GeneticDataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteGeneticData",
 			Handler:    _GeneticDataService_DeleteGeneticData_Handler,
 		},
+		{
+			MethodName: "RestoreGeneticData",
+			Handler:    _GeneticDataService_RestoreGeneticData_Handler,
+		},
 		{
 			MethodName: "ListGeneticData",
 			Handler:    _GeneticDataService_ListGeneticData_Handler,
@@ -676,21 +834,24 @@ var GeneticDataService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	LifestyleDataService_CreateLifestyleData_FullMethodName = "/health.LifestyleDataService/CreateLifestyleData"
-	LifestyleDataService_GetLifestyleData_FullMethodName    = "/health.LifestyleDataService/GetLifestyleData"
-	LifestyleDataService_UpdateLifestyleData_FullMethodName = "/health.LifestyleDataService/UpdateLifestyleData"
-	LifestyleDataService_DeleteLifestyleData_FullMethodName = "/health.LifestyleDataService/DeleteLifestyleData"
-	LifestyleDataService_ListLifestyleData_FullMethodName   = "/health.LifestyleDataService/ListLifestyleData"
+	LifestyleDataService_CreateLifestyleData_FullMethodName  = "/health.LifestyleDataService/CreateLifestyleData"
+	LifestyleDataService_GetLifestyleData_FullMethodName     = "/health.LifestyleDataService/GetLifestyleData"
+	LifestyleDataService_UpdateLifestyleData_FullMethodName  = "/health.LifestyleDataService/UpdateLifestyleData"
+	LifestyleDataService_DeleteLifestyleData_FullMethodName  = "/health.LifestyleDataService/DeleteLifestyleData"
+	LifestyleDataService_RestoreLifestyleData_FullMethodName = "/health.LifestyleDataService/RestoreLifestyleData"
+	LifestyleDataService_ListLifestyleData_FullMethodName    = "/health.LifestyleDataService/ListLifestyleData"
 )
 
 // LifestyleDataServiceClient is the client API for LifestyleDataService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LifestyleDataServiceClient interface {
-	CreateLifestyleData(ctx context.Context, in *LifestyleData, opts ...grpc.CallOption) (*Empty, error)
+	CreateLifestyleData(ctx context.Context, in *LifestyleData, opts ...grpc.CallOption) (*LifestyleData, error)
 	GetLifestyleData(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*LifestyleData, error)
-	UpdateLifestyleData(ctx context.Context, in *LifestyleData, opts ...grpc.CallOption) (*Empty, error)
+	UpdateLifestyleData(ctx context.Context, in *LifestyleData, opts ...grpc.CallOption) (*LifestyleData, error)
 	DeleteLifestyleData(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Restores a deleted lifestyle data record until it is purged by the retention job
+	RestoreLifestyleData(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*LifestyleData, error)
 	ListLifestyleData(ctx context.Context, in *ListLifestyleDataRequest, opts ...grpc.CallOption) (*ListLifestyleDataResponse, error)
 }
 
@@ -702,9 +863,9 @@ func NewLifestyleDataServiceClient(cc grpc.ClientConnInterface) LifestyleDataSer
 	return &lifestyleDataServiceClient{cc}
 }
 
-func (c *lifestyleDataServiceClient) CreateLifestyleData(ctx context.Context, in *LifestyleData, opts ...grpc.CallOption) (*Empty, error) {
+func (c *lifestyleDataServiceClient) CreateLifestyleData(ctx context.Context, in *LifestyleData, opts ...grpc.CallOption) (*LifestyleData, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
+	out := new(LifestyleData)
 	err := c.cc.Invoke(ctx, LifestyleDataService_CreateLifestyleData_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -722,9 +883,9 @@ func (c *lifestyleDataServiceClient) GetLifestyleData(ctx context.Context, in *B
 	return out, nil
 }
 
-func (c *lifestyleDataServiceClient) UpdateLifestyleData(ctx context.Context, in *LifestyleData, opts ...grpc.CallOption) (*Empty, error) {
+func (c *lifestyleDataServiceClient) UpdateLifestyleData(ctx context.Context, in *LifestyleData, opts ...grpc.CallOption) (*LifestyleData, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
+	out := new(LifestyleData)
 	err := c.cc.Invoke(ctx, LifestyleDataService_UpdateLifestyleData_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -742,6 +903,16 @@ func (c *lifestyleDataServiceClient) DeleteLifestyleData(ctx context.Context, in
 	return out, nil
 }
 
+func (c *lifestyleDataServiceClient) RestoreLifestyleData(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*LifestyleData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LifestyleData)
+	err := c.cc.Invoke(ctx, LifestyleDataService_RestoreLifestyleData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lifestyleDataServiceClient) ListLifestyleData(ctx context.Context, in *ListLifestyleDataRequest, opts ...grpc.CallOption) (*ListLifestyleDataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListLifestyleDataResponse)
@@ -756,10 +927,12 @@ func (c *lifestyleDataServiceClient) ListLifestyleData(ctx context.Context, in *
 // All implementations must embed UnimplementedLifestyleDataServiceServer
 // for forward compatibility.
 type LifestyleDataServiceServer interface {
-	CreateLifestyleData(context.Context, *LifestyleData) (*Empty, error)
+	CreateLifestyleData(context.Context, *LifestyleData) (*LifestyleData, error)
 	GetLifestyleData(context.Context, *ByIdRequest) (*LifestyleData, error)
-	UpdateLifestyleData(context.Context, *LifestyleData) (*Empty, error)
+	UpdateLifestyleData(context.Context, *LifestyleData) (*LifestyleData, error)
 	DeleteLifestyleData(context.Context, *ByIdRequest) (*Empty, error)
+	// Restores a deleted lifestyle data record until it is purged by the retention job
+	RestoreLifestyleData(context.Context, *ByIdRequest) (*LifestyleData, error)
 	ListLifestyleData(context.Context, *ListLifestyleDataRequest) (*ListLifestyleDataResponse, error)
 	mustEmbedUnimplementedLifestyleDataServiceServer()
 }
@@ -771,18 +944,21 @@ type LifestyleDataServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedLifestyleDataServiceServer struct{}
 
-func (UnimplementedLifestyleDataServiceServer) CreateLifestyleData(context.Context, *LifestyleData) (*Empty, error) {
+func (UnimplementedLifestyleDataServiceServer) CreateLifestyleData(context.Context, *LifestyleData) (*LifestyleData, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLifestyleData not implemented")
 }
 func (UnimplementedLifestyleDataServiceServer) GetLifestyleData(context.Context, *ByIdRequest) (*LifestyleData, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLifestyleData not implemented")
 }
-func (UnimplementedLifestyleDataServiceServer) UpdateLifestyleData(context.Context, *LifestyleData) (*Empty, error) {
+func (UnimplementedLifestyleDataServiceServer) UpdateLifestyleData(context.Context, *LifestyleData) (*LifestyleData, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLifestyleData not implemented")
 }
 func (UnimplementedLifestyleDataServiceServer) DeleteLifestyleData(context.Context, *ByIdRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLifestyleData not implemented")
 }
+func (UnimplementedLifestyleDataServiceServer) RestoreLifestyleData(context.Context, *ByIdRequest) (*LifestyleData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreLifestyleData not implemented")
+}
 func (UnimplementedLifestyleDataServiceServer) ListLifestyleData(context.Context, *ListLifestyleDataRequest) (*ListLifestyleDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLifestyleData not implemented")
 }
@@ -879,6 +1055,24 @@ func _LifestyleDataService_DeleteLifestyleData_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LifestyleDataService_RestoreLifestyleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LifestyleDataServiceServer).RestoreLifestyleData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LifestyleDataService_RestoreLifestyleData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LifestyleDataServiceServer).RestoreLifestyleData(ctx, req.(*ByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LifestyleDataService_ListLifestyleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListLifestyleDataRequest)
 	if err := dec(in); err != nil {
@@ -920,6 +1114,10 @@ var LifestyleDataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLifestyleData",
 			Handler:    _LifestyleDataService_DeleteLifestyleData_Handler,
 		},
+		{
+			MethodName: "RestoreLifestyleData",
+			Handler:    _LifestyleDataService_RestoreLifestyleData_Handler,
+		},
 		{
 			MethodName: "ListLifestyleData",
 			Handler:    _LifestyleDataService_ListLifestyleData_Handler,
@@ -930,21 +1128,24 @@ var LifestyleDataService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	WearableDataService_CreateWearableData_FullMethodName = "/health.WearableDataService/CreateWearableData"
-	WearableDataService_GetWearableData_FullMethodName    = "/health.WearableDataService/GetWearableData"
-	WearableDataService_UpdateWearableData_FullMethodName = "/health.WearableDataService/UpdateWearableData"
-	WearableDataService_DeleteWearableData_FullMethodName = "/health.WearableDataService/DeleteWearableData"
-	WearableDataService_ListWearableData_FullMethodName   = "/health.WearableDataService/ListWearableData"
+	WearableDataService_CreateWearableData_FullMethodName  = "/health.WearableDataService/CreateWearableData"
+	WearableDataService_GetWearableData_FullMethodName     = "/health.WearableDataService/GetWearableData"
+	WearableDataService_UpdateWearableData_FullMethodName  = "/health.WearableDataService/UpdateWearableData"
+	WearableDataService_DeleteWearableData_FullMethodName  = "/health.WearableDataService/DeleteWearableData"
+	WearableDataService_RestoreWearableData_FullMethodName = "/health.WearableDataService/RestoreWearableData"
+	WearableDataService_ListWearableData_FullMethodName    = "/health.WearableDataService/ListWearableData"
 )
 
 // WearableDataServiceClient is the client API for WearableDataService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WearableDataServiceClient interface {
-	CreateWearableData(ctx context.Context, in *WearableData, opts ...grpc.CallOption) (*Empty, error)
+	CreateWearableData(ctx context.Context, in *WearableData, opts ...grpc.CallOption) (*WearableData, error)
 	GetWearableData(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*WearableData, error)
-	UpdateWearableData(ctx context.Context, in *WearableData, opts ...grpc.CallOption) (*Empty, error)
+	UpdateWearableData(ctx context.Context, in *WearableData, opts ...grpc.CallOption) (*WearableData, error)
 	DeleteWearableData(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Restores a deleted wearable data record until it is purged by the retention job
+	RestoreWearableData(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*WearableData, error)
 	ListWearableData(ctx context.Context, in *ListWearableDataRequest, opts ...grpc.CallOption) (*ListWearableDataResponse, error)
 }
 
@@ -956,9 +1157,9 @@ func NewWearableDataServiceClient(cc grpc.ClientConnInterface) WearableDataServi
 	return &wearableDataServiceClient{cc}
 }
 
-func (c *wearableDataServiceClient) CreateWearableData(ctx context.Context, in *WearableData, opts ...grpc.CallOption) (*Empty, error) {
+func (c *wearableDataServiceClient) CreateWearableData(ctx context.Context, in *WearableData, opts ...grpc.CallOption) (*WearableData, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
+	out := new(WearableData)
 	err := c.cc.Invoke(ctx, WearableDataService_CreateWearableData_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -976,9 +1177,9 @@ func (c *wearableDataServiceClient) GetWearableData(ctx context.Context, in *ByI
 	return out, nil
 }
 
-func (c *wearableDataServiceClient) UpdateWearableData(ctx context.Context, in *WearableData, opts ...grpc.CallOption) (*Empty, error) {
+func (c *wearableDataServiceClient) UpdateWearableData(ctx context.Context, in *WearableData, opts ...grpc.CallOption) (*WearableData, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
+	out := new(WearableData)
 	err := c.cc.Invoke(ctx, WearableDataService_UpdateWearableData_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -996,6 +1197,16 @@ func (c *wearableDataServiceClient) DeleteWearableData(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *wearableDataServiceClient) RestoreWearableData(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*WearableData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WearableData)
+	err := c.cc.Invoke(ctx, WearableDataService_RestoreWearableData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *wearableDataServiceClient) ListWearableData(ctx context.Context, in *ListWearableDataRequest, opts ...grpc.CallOption) (*ListWearableDataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListWearableDataResponse)
@@ -1010,10 +1221,12 @@ func (c *wearableDataServiceClient) ListWearableData(ctx context.Context, in *Li
 // All implementations must embed UnimplementedWearableDataServiceServer
 // for forward compatibility.
 type WearableDataServiceServer interface {
-	CreateWearableData(context.Context, *WearableData) (*Empty, error)
+	CreateWearableData(context.Context, *WearableData) (*WearableData, error)
 	GetWearableData(context.Context, *ByIdRequest) (*WearableData, error)
-	UpdateWearableData(context.Context, *WearableData) (*Empty, error)
+	UpdateWearableData(context.Context, *WearableData) (*WearableData, error)
 	DeleteWearableData(context.Context, *ByIdRequest) (*Empty, error)
+	// Restores a deleted wearable data record until it is purged by the retention job
+	RestoreWearableData(context.Context, *ByIdRequest) (*WearableData, error)
 	ListWearableData(context.Context, *ListWearableDataRequest) (*ListWearableDataResponse, error)
 	mustEmbedUnimplementedWearableDataServiceServer()
 }
@@ -1025,18 +1238,21 @@ type WearableDataServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedWearableDataServiceServer struct{}
 
-func (UnimplementedWearableDataServiceServer) CreateWearableData(context.Context, *WearableData) (*Empty, error) {
+func (UnimplementedWearableDataServiceServer) CreateWearableData(context.Context, *WearableData) (*WearableData, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateWearableData not implemented")
 }
 func (UnimplementedWearableDataServiceServer) GetWearableData(context.Context, *ByIdRequest) (*WearableData, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWearableData not implemented")
 }
-func (UnimplementedWearableDataServiceServer) UpdateWearableData(context.Context, *WearableData) (*Empty, error) {
+func (UnimplementedWearableDataServiceServer) UpdateWearableData(context.Context, *WearableData) (*WearableData, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateWearableData not implemented")
 }
 func (UnimplementedWearableDataServiceServer) DeleteWearableData(context.Context, *ByIdRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWearableData not implemented")
 }
+func (UnimplementedWearableDataServiceServer) RestoreWearableData(context.Context, *ByIdRequest) (*WearableData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreWearableData not implemented")
+}
 func (UnimplementedWearableDataServiceServer) ListWearableData(context.Context, *ListWearableDataRequest) (*ListWearableDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWearableData not implemented")
 }
@@ -1133,6 +1349,24 @@ func _WearableDataService_DeleteWearableData_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WearableDataService_RestoreWearableData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WearableDataServiceServer).RestoreWearableData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WearableDataService_RestoreWearableData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WearableDataServiceServer).RestoreWearableData(ctx, req.(*ByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WearableDataService_ListWearableData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListWearableDataRequest)
 	if err := dec(in); err != nil {
@@ -1174,6 +1408,10 @@ var WearableDataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWearableData",
 			Handler:    _WearableDataService_DeleteWearableData_Handler,
 		},
+		{
+			MethodName: "RestoreWearableData",
+			Handler:    _WearableDataService_RestoreWearableData_Handler,
+		},
 		{
 			MethodName: "ListWearableData",
 			Handler:    _WearableDataService_ListWearableData_Handler,
@@ -1184,21 +1422,24 @@ var WearableDataService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	HealthRecommendationService_CreateHealthRecommendation_FullMethodName = "/health.HealthRecommendationService/CreateHealthRecommendation"
-	HealthRecommendationService_GetHealthRecommendation_FullMethodName    = "/health.HealthRecommendationService/GetHealthRecommendation"
-	HealthRecommendationService_UpdateHealthRecommendation_FullMethodName = "/health.HealthRecommendationService/UpdateHealthRecommendation"
-	HealthRecommendationService_DeleteHealthRecommendation_FullMethodName = "/health.HealthRecommendationService/DeleteHealthRecommendation"
-	HealthRecommendationService_ListHealthRecommendations_FullMethodName  = "/health.HealthRecommendationService/ListHealthRecommendations"
+	HealthRecommendationService_CreateHealthRecommendation_FullMethodName  = "/health.HealthRecommendationService/CreateHealthRecommendation"
+	HealthRecommendationService_GetHealthRecommendation_FullMethodName     = "/health.HealthRecommendationService/GetHealthRecommendation"
+	HealthRecommendationService_UpdateHealthRecommendation_FullMethodName  = "/health.HealthRecommendationService/UpdateHealthRecommendation"
+	HealthRecommendationService_DeleteHealthRecommendation_FullMethodName  = "/health.HealthRecommendationService/DeleteHealthRecommendation"
+	HealthRecommendationService_RestoreHealthRecommendation_FullMethodName = "/health.HealthRecommendationService/RestoreHealthRecommendation"
+	HealthRecommendationService_ListHealthRecommendations_FullMethodName   = "/health.HealthRecommendationService/ListHealthRecommendations"
 )
 
 // HealthRecommendationServiceClient is the client API for HealthRecommendationService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type HealthRecommendationServiceClient interface {
-	CreateHealthRecommendation(ctx context.Context, in *HealthRecommendation, opts ...grpc.CallOption) (*Empty, error)
+	CreateHealthRecommendation(ctx context.Context, in *HealthRecommendation, opts ...grpc.CallOption) (*HealthRecommendation, error)
 	GetHealthRecommendation(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*HealthRecommendation, error)
-	UpdateHealthRecommendation(ctx context.Context, in *HealthRecommendation, opts ...grpc.CallOption) (*Empty, error)
+	UpdateHealthRecommendation(ctx context.Context, in *HealthRecommendation, opts ...grpc.CallOption) (*HealthRecommendation, error)
 	DeleteHealthRecommendation(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Restores a deleted health recommendation until it is purged by the retention job
+	RestoreHealthRecommendation(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*HealthRecommendation, error)
 	ListHealthRecommendations(ctx context.Context, in *ListHealthRecommendationsRequest, opts ...grpc.CallOption) (*ListHealthRecommendationsResponse, error)
 }
 
@@ -1210,9 +1451,9 @@ func NewHealthRecommendationServiceClient(cc grpc.ClientConnInterface) HealthRec
 	return &healthRecommendationServiceClient{cc}
 }
 
-func (c *healthRecommendationServiceClient) CreateHealthRecommendation(ctx context.Context, in *HealthRecommendation, opts ...grpc.CallOption) (*Empty, error) {
+func (c *healthRecommendationServiceClient) CreateHealthRecommendation(ctx context.Context, in *HealthRecommendation, opts ...grpc.CallOption) (*HealthRecommendation, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
+	out := new(HealthRecommendation)
 	err := c.cc.Invoke(ctx, HealthRecommendationService_CreateHealthRecommendation_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1230,9 +1471,9 @@ func (c *healthRecommendationServiceClient) GetHealthRecommendation(ctx context.
 	return out, nil
 }
 
-func (c *healthRecommendationServiceClient) UpdateHealthRecommendation(ctx context.Context, in *HealthRecommendation, opts ...grpc.CallOption) (*Empty, error) {
+func (c *healthRecommendationServiceClient) UpdateHealthRecommendation(ctx context.Context, in *HealthRecommendation, opts ...grpc.CallOption) (*HealthRecommendation, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
+	out := new(HealthRecommendation)
 	err := c.cc.Invoke(ctx, HealthRecommendationService_UpdateHealthRecommendation_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1250,6 +1491,16 @@ func (c *healthRecommendationServiceClient) DeleteHealthRecommendation(ctx conte
 	return out, nil
 }
 
+func (c *healthRecommendationServiceClient) RestoreHealthRecommendation(ctx context.Context, in *ByIdRequest, opts ...grpc.CallOption) (*HealthRecommendation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthRecommendation)
+	err := c.cc.Invoke(ctx, HealthRecommendationService_RestoreHealthRecommendation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *healthRecommendationServiceClient) ListHealthRecommendations(ctx context.Context, in *ListHealthRecommendationsRequest, opts ...grpc.CallOption) (*ListHealthRecommendationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListHealthRecommendationsResponse)
@@ -1264,10 +1515,12 @@ func (c *healthRecommendationServiceClient) ListHealthRecommendations(ctx contex
 // All implementations must embed UnimplementedHealthRecommendationServiceServer
 // for forward compatibility.
 type HealthRecommendationServiceServer interface {
-	CreateHealthRecommendation(context.Context, *HealthRecommendation) (*Empty, error)
+	CreateHealthRecommendation(context.Context, *HealthRecommendation) (*HealthRecommendation, error)
 	GetHealthRecommendation(context.Context, *ByIdRequest) (*HealthRecommendation, error)
-	UpdateHealthRecommendation(context.Context, *HealthRecommendation) (*Empty, error)
+	UpdateHealthRecommendation(context.Context, *HealthRecommendation) (*HealthRecommendation, error)
 	DeleteHealthRecommendation(context.Context, *ByIdRequest) (*Empty, error)
+	// Restores a deleted health recommendation until it is purged by the retention job
+	RestoreHealthRecommendation(context.Context, *ByIdRequest) (*HealthRecommendation, error)
 	ListHealthRecommendations(context.Context, *ListHealthRecommendationsRequest) (*ListHealthRecommendationsResponse, error)
 	mustEmbedUnimplementedHealthRecommendationServiceServer()
 }
@@ -1279,18 +1532,21 @@ type HealthRecommendationServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedHealthRecommendationServiceServer struct{}
 
-func (UnimplementedHealthRecommendationServiceServer) CreateHealthRecommendation(context.Context, *HealthRecommendation) (*Empty, error) {
+func (UnimplementedHealthRecommendationServiceServer) CreateHealthRecommendation(context.Context, *HealthRecommendation) (*HealthRecommendation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateHealthRecommendation not implemented")
 }
 func (UnimplementedHealthRecommendationServiceServer) GetHealthRecommendation(context.Context, *ByIdRequest) (*HealthRecommendation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHealthRecommendation not implemented")
 }
-func (UnimplementedHealthRecommendationServiceServer) UpdateHealthRecommendation(context.Context, *HealthRecommendation) (*Empty, error) {
+func (UnimplementedHealthRecommendationServiceServer) UpdateHealthRecommendation(context.Context, *HealthRecommendation) (*HealthRecommendation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateHealthRecommendation not implemented")
 }
 func (UnimplementedHealthRecommendationServiceServer) DeleteHealthRecommendation(context.Context, *ByIdRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteHealthRecommendation not implemented")
 }
+func (UnimplementedHealthRecommendationServiceServer) RestoreHealthRecommendation(context.Context, *ByIdRequest) (*HealthRecommendation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreHealthRecommendation not implemented")
+}
 func (UnimplementedHealthRecommendationServiceServer) ListHealthRecommendations(context.Context, *ListHealthRecommendationsRequest) (*ListHealthRecommendationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListHealthRecommendations not implemented")
 }
@@ -1388,6 +1644,24 @@ func _HealthRecommendationService_DeleteHealthRecommendation_Handler(srv interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HealthRecommendationService_RestoreHealthRecommendation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthRecommendationServiceServer).RestoreHealthRecommendation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthRecommendationService_RestoreHealthRecommendation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthRecommendationServiceServer).RestoreHealthRecommendation(ctx, req.(*ByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HealthRecommendationService_ListHealthRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListHealthRecommendationsRequest)
 	if err := dec(in); err != nil {
@@ -1429,6 +1703,10 @@ var HealthRecommendationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteHealthRecommendation",
 			Handler:    _HealthRecommendationService_DeleteHealthRecommendation_Handler,
 		},
+		{
+			MethodName: "RestoreHealthRecommendation",
+			Handler:    _HealthRecommendationService_RestoreHealthRecommendation_Handler,
+		},
 		{
 			MethodName: "ListHealthRecommendations",
 			Handler:    _HealthRecommendationService_ListHealthRecommendations_Handler,
@@ -0,0 +1,265 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/audit"
+	"github.com/health-analytics-service/health-analytics-service/config"
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"github.com/segmentio/kafka-go"
+)
+
+// Entity is implemented by the generated messages consumed from Kafka.
+type Entity interface {
+	GetId() string
+	GetUserId() string
+}
+
+// entityPtr constrains PT to be a pointer to T implementing Entity, so that the
+// consumer can allocate and unmarshal messages of type T.
+type entityPtr[T any] interface {
+	*T
+	Entity
+}
+
+// Handler applies a decoded message to the storage and returns the stored entity.
+type Handler[PT Entity] func(ctx context.Context, model PT) (PT, error)
+
+// Options configures how a Consumer reads and processes its topic.
+type Options struct {
+	GroupID      string        // Kafka consumer group
+	Workers      int           // Maximum number of messages processed concurrently
+	BatchSize    int           // Maximum number of messages fetched before committing
+	BatchTimeout time.Duration // How long to wait for a batch to fill up
+	Retry        RetryPolicy
+}
+
+// OptionsFromConfig builds the consumer options from the service configuration.
+func OptionsFromConfig(cfg config.Config, groupID string) Options {
+	return Options{
+		GroupID:      groupID,
+		Workers:      cfg.KafkaConsumerWorkers,
+		BatchSize:    cfg.KafkaConsumerBatchSize,
+		BatchTimeout: cfg.KafkaConsumerBatchTimeout,
+		Retry:        RetryPolicyFromConfig(cfg),
+	}
+}
+
+// Consumer consumes a topic whose messages are keyed by action (e.g. "genetic_data.create")
+// and carry a JSON encoded T. Messages are fetched in batches and dispatched to a bounded
+// pool of workers; messages of the same user always go to the same worker, so they are
+// applied in order. Offsets are committed once the whole batch has been processed.
+type Consumer[T any, PT entityPtr[T]] struct {
+	reader    *kafka.Reader
+	processor *Processor
+	auditLog  *audit.Logger
+	handlers  map[string]Handler[PT]
+	opts      Options
+}
+
+// NewConsumer creates a Consumer dispatching messages to handlers by message key.
+// The outcome of every message is recorded in auditLog, unless it is nil.
+func NewConsumer[T any, PT entityPtr[T]](kafkaBrokers []string, topic string, opts Options, auditLog *audit.Logger, handlers map[string]Handler[PT]) *Consumer[T, PT] {
+	if opts.Workers < 1 {
+		opts.Workers = 1
+	}
+	if opts.BatchSize < 1 {
+		opts.BatchSize = 1
+	}
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: kafkaBrokers,
+		Topic:   topic,
+		GroupID: opts.GroupID,
+	})
+	return &Consumer[T, PT]{
+		reader:    reader,
+		processor: NewProcessor(kafkaBrokers, topic, opts.Retry),
+		auditLog:  auditLog,
+		handlers:  handlers,
+		opts:      opts,
+	}
+}
+
+// Consume starts consuming messages from the Kafka topic until ctx is cancelled or
+// a message can neither be processed nor dead-lettered.
+func (c *Consumer[T, PT]) Consume(ctx context.Context) error {
+	for {
+		batch, err := c.fetchBatch(ctx)
+		if err != nil {
+			return fmt.Errorf("error fetching message: %w", err)
+		}
+
+		if err := c.processBatch(ctx, batch); err != nil {
+			return err
+		}
+
+		// Commit the batch
+		if err := c.reader.CommitMessages(ctx, batch...); err != nil {
+			return fmt.Errorf("error committing messages: %w", err)
+		}
+	}
+}
+
+// Close closes the reader and the dead-letter writer.
+func (c *Consumer[T, PT]) Close() error {
+	return errors.Join(c.reader.Close(), c.processor.Close())
+}
+
+// fetchBatch blocks for the first message, then collects up to BatchSize messages
+// that arrive within BatchTimeout.
+func (c *Consumer[T, PT]) fetchBatch(ctx context.Context) ([]kafka.Message, error) {
+	msg, err := c.reader.FetchMessage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch := []kafka.Message{msg}
+	if c.opts.BatchSize == 1 || c.opts.BatchTimeout <= 0 {
+		return batch, nil
+	}
+
+	batchCtx, cancel := context.WithTimeout(ctx, c.opts.BatchTimeout)
+	defer cancel()
+	for len(batch) < c.opts.BatchSize {
+		msg, err := c.reader.FetchMessage(batchCtx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			break // The batch timed out
+		}
+		batch = append(batch, msg)
+	}
+	return batch, nil
+}
+
+// processBatch processes a batch on up to Workers goroutines, partitioned by user.
+func (c *Consumer[T, PT]) processBatch(ctx context.Context, batch []kafka.Message) error {
+	partitions := make([][]kafka.Message, c.opts.Workers)
+	for _, msg := range batch {
+		i := partitionOf(msg, c.opts.Workers)
+		partitions[i] = append(partitions[i], msg)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	for _, messages := range partitions {
+		if len(messages) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(messages []kafka.Message) {
+			defer wg.Done()
+			for _, msg := range messages {
+				if err := c.process(ctx, msg); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					return
+				}
+			}
+		}(messages)
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// process processes a message with retries and audits its final outcome: applied, or
+// dead-lettered with the last error.
+func (c *Consumer[T, PT]) process(ctx context.Context, msg kafka.Message) error {
+	var (
+		entity     PT
+		handlerErr error
+	)
+	err := c.processor.Process(ctx, msg, func(ctx context.Context, msg kafka.Message) error {
+		entity, handlerErr = c.handleMessage(ctx, msg)
+		return handlerErr
+	})
+	if err == nil && c.auditLog != nil {
+		c.auditLog.Record(ctx, auditEntry(msg, entity, handlerErr))
+	}
+	return err
+}
+
+// handleMessage decodes a message and runs the handler registered for its key. It
+// returns the stored entity, or the decoded one when the handler fails.
+// Malformed messages are reported as permanent errors so they are dead-lettered without retrying.
+func (c *Consumer[T, PT]) handleMessage(ctx context.Context, msg kafka.Message) (PT, error) {
+	handler, ok := c.handlers[string(msg.Key)]
+	if !ok {
+		return nil, permanent(fmt.Errorf("unknown message key: %s", msg.Key))
+	}
+
+	model := PT(new(T))
+	if err := json.Unmarshal(msg.Value, model); err != nil {
+		return nil, permanent(fmt.Errorf("error unmarshalling %s message: %w", msg.Key, err))
+	}
+	stored, err := handler(storage.WithActor(ctx, messageActor(msg)), model)
+	if err != nil {
+		return model, err
+	}
+	return stored, nil
+}
+
+// auditEntry describes the outcome of a message whose key is "<resource_type>.<action>".
+func auditEntry[T any, PT entityPtr[T]](msg kafka.Message, entity PT, err error) *health.AuditEntry {
+	resourceType, action, _ := strings.Cut(string(msg.Key), ".")
+	entry := &health.AuditEntry{
+		Actor:        messageActor(msg),
+		Action:       action,
+		ResourceType: resourceType,
+		Outcome:      errs.Code(err).String(),
+		Source:       audit.SourceKafka,
+		Method:       string(msg.Key),
+	}
+	if entity != nil {
+		entry.ResourceId = entity.GetId()
+		entry.UserId = entity.GetUserId()
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	return entry
+}
+
+// messageActor returns the user on whose behalf a message was sent, taken from the
+// x-actor header set by the producer, or the topic when unknown.
+func messageActor(msg kafka.Message) string {
+	for _, h := range msg.Headers {
+		if h.Key == HeaderActor {
+			return string(h.Value)
+		}
+	}
+	return "kafka:" + msg.Topic
+}
+
+// partitionOf returns the worker of a message, derived from the user_id of its
+// payload (or the message key when the payload has none).
+func partitionOf(msg kafka.Message, workers int) int {
+	if workers == 1 {
+		return 0
+	}
+	var payload struct {
+		UserID string `json:"user_id"`
+	}
+	key := msg.Key
+	if err := json.Unmarshal(msg.Value, &payload); err == nil && payload.UserID != "" {
+		key = []byte(payload.UserID)
+	}
+	h := fnv.New32a()
+	h.Write(key)
+	return int(h.Sum32() % uint32(workers))
+}
@@ -0,0 +1,55 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/storage/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// notify sends a notification to the user. Failures are only logged, as the
+// message itself has been applied.
+func notify(ctx context.Context, redis *redis.Client, userID, message string) {
+	if err := redis.AddNotification(ctx, userID, message); err != nil {
+		log.Printf("failed to send notification: %v", err)
+	}
+}
+
+// deleteHandler returns the handler of a *.delete key, whose payload carries the id
+// and user_id of the entity to delete. The entity must belong to that user; a
+// mismatch is dead-lettered. Deleting an entity that no longer exists (e.g. a
+// redelivered message) is not an error.
+func deleteHandler[T any, PT entityPtr[T]](entity string, get func(ctx context.Context, id string) (PT, error), del func(ctx context.Context, id string) error, redis *redis.Client, message string) Handler[PT] {
+	return func(ctx context.Context, model PT) (PT, error) {
+		if model.GetId() == "" {
+			return nil, permanent(errors.New("delete message is missing its id"))
+		}
+		if model.GetUserId() == "" {
+			return nil, permanent(errors.New("delete message is missing its user_id"))
+		}
+
+		stored, err := get(ctx, model.GetId())
+		if err == nil {
+			if stored.GetUserId() != model.GetUserId() {
+				return nil, permanent(errs.PermissionDenied("NOT_OWNER", "%s %s does not belong to user %s", entity, model.GetId(), model.GetUserId()))
+			}
+			err = del(ctx, model.GetId())
+		}
+		if err != nil {
+			if status.Code(err) != codes.NotFound {
+				return nil, fmt.Errorf("error deleting %s: %w", entity, err)
+			}
+			log.Printf("%s %s already deleted", entity, model.GetId())
+			return model, nil
+		}
+
+		// Send notification for deletion to the owner of the entity
+		notify(ctx, redis, stored.GetUserId(), message)
+		return stored, nil
+	}
+}
@@ -0,0 +1,184 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/config"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Headers added to messages routed to a dead-letter topic.
+const (
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+	HeaderError             = "x-error"
+	HeaderErrorClass        = "x-error-class"
+	HeaderAttempts          = "x-attempts"
+	HeaderFailedAt          = "x-failed-at"
+)
+
+// HeaderActor may be set by producers to the user on whose behalf a message was
+// sent; it is recorded as the actor in the audit log and of soft deletes.
+const HeaderActor = "x-actor"
+
+// RetryPolicy configures how failed messages are retried and dead-lettered.
+type RetryPolicy struct {
+	MaxRetries     int           // Retries after the first attempt for transient errors
+	InitialBackoff time.Duration // Delay before the first retry, doubled on every retry
+	MaxBackoff     time.Duration // Upper bound of the retry delay
+	DLQSuffix      string        // Suffix appended to the source topic to name its dead-letter topic
+}
+
+// RetryPolicyFromConfig builds the retry policy from the service configuration.
+func RetryPolicyFromConfig(cfg config.Config) RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:     cfg.KafkaMaxRetries,
+		InitialBackoff: cfg.KafkaRetryBackoff,
+		MaxBackoff:     cfg.KafkaMaxRetryBackoff,
+		DLQSuffix:      cfg.KafkaDLQSuffix,
+	}
+}
+
+// backoff returns the delay before the given retry (starting at 1), with jitter.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < retry && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+	// Up to 20% jitter so that consumers do not retry in lockstep
+	return delay + time.Duration(rand.Int63n(int64(delay)/5+1))
+}
+
+// permanentError marks a failure that retrying cannot fix.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// permanent marks err as non-retryable, e.g. for malformed payloads.
+func permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// isPermanent reports whether err cannot be fixed by retrying: either it was marked
+// with permanent or it carries a gRPC status describing an invalid request.
+func isPermanent(err error) bool {
+	var pe *permanentError
+	if errors.As(err, &pe) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists, codes.FailedPrecondition,
+		codes.OutOfRange, codes.Unimplemented, codes.PermissionDenied, codes.Unauthenticated:
+		return true
+	}
+	return false
+}
+
+// Processor runs message handlers with retries for transient errors and routes
+// messages that still cannot be processed to the topic's dead-letter topic.
+type Processor struct {
+	topic  string
+	policy RetryPolicy
+	dlq    *kafka.Writer
+}
+
+// NewProcessor creates a Processor for the given source topic.
+func NewProcessor(kafkaBrokers []string, topic string, policy RetryPolicy) *Processor {
+	return &Processor{
+		topic:  topic,
+		policy: policy,
+		dlq: &kafka.Writer{
+			Addr:                   kafka.TCP(kafkaBrokers...),
+			Topic:                  topic + policy.DLQSuffix,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+		},
+	}
+}
+
+// Process handles a message. Transient failures are retried with exponential backoff;
+// permanent failures and messages that exhaust their retries are written to the
+// dead-letter topic. A nil result means the message may be committed. An error is
+// only returned when the message could not be dead-lettered or ctx was cancelled.
+func (p *Processor) Process(ctx context.Context, msg kafka.Message, handle func(context.Context, kafka.Message) error) error {
+	var (
+		err      error
+		attempts int
+	)
+	for {
+		attempts++
+		if err = handle(ctx, msg); err == nil {
+			return nil
+		}
+		if isPermanent(err) || attempts > p.policy.MaxRetries {
+			break
+		}
+
+		delay := p.policy.backoff(attempts)
+		log.Printf("retrying message %s/%d/%d in %s (attempt %d): %v", msg.Topic, msg.Partition, msg.Offset, delay, attempts, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+
+	log.Printf("dead-lettering message %s/%d/%d after %d attempt(s): %v", msg.Topic, msg.Partition, msg.Offset, attempts, err)
+	return p.deadLetter(ctx, msg, err, attempts)
+}
+
+// deadLetter writes the original message to the dead-letter topic along with headers
+// describing the failure.
+func (p *Processor) deadLetter(ctx context.Context, msg kafka.Message, cause error, attempts int) error {
+	class := "transient"
+	if isPermanent(cause) {
+		class = "permanent"
+	}
+
+	headers := append([]kafka.Header{}, msg.Headers...)
+	headers = append(headers,
+		kafka.Header{Key: HeaderOriginalTopic, Value: []byte(p.topic)},
+		kafka.Header{Key: HeaderOriginalPartition, Value: []byte(strconv.Itoa(msg.Partition))},
+		kafka.Header{Key: HeaderOriginalOffset, Value: []byte(strconv.FormatInt(msg.Offset, 10))},
+		kafka.Header{Key: HeaderError, Value: []byte(cause.Error())},
+		kafka.Header{Key: HeaderErrorClass, Value: []byte(class)},
+		kafka.Header{Key: HeaderAttempts, Value: []byte(strconv.Itoa(attempts))},
+		kafka.Header{Key: HeaderFailedAt, Value: []byte(time.Now().UTC().Format(time.RFC3339))},
+	)
+
+	if err := p.dlq.WriteMessages(ctx, kafka.Message{
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	}); err != nil {
+		return fmt.Errorf("error writing message to dead-letter topic %s: %w", p.dlq.Topic, err)
+	}
+	return nil
+}
+
+// Close flushes and closes the dead-letter writer.
+func (p *Processor) Close() error {
+	return p.dlq.Close()
+}
@@ -0,0 +1,78 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// ReplayDeadLetters moves the messages of a dead-letter topic back to the topic they
+// originally came from, stripping the failure headers added when they were
+// dead-lettered. It stops after limit messages (0 for no limit) or once no message
+// arrived for idleTimeout, and returns the number of replayed messages.
+func ReplayDeadLetters(ctx context.Context, kafkaBrokers []string, dlqTopic string, policy RetryPolicy, idleTimeout time.Duration, limit int) (int, error) {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: kafkaBrokers,
+		Topic:   dlqTopic,
+		GroupID: "dlq-replay-" + dlqTopic,
+	})
+	defer reader.Close()
+
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(kafkaBrokers...),
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}
+	defer writer.Close()
+
+	replayed := 0
+	for limit == 0 || replayed < limit {
+		fetchCtx, cancel := context.WithTimeout(ctx, idleTimeout)
+		msg, err := reader.FetchMessage(fetchCtx)
+		cancel()
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+				break // The dead-letter topic is drained
+			}
+			return replayed, fmt.Errorf("error fetching dead-lettered message: %w", err)
+		}
+
+		// Find the source topic, falling back to the dead-letter topic name
+		topic := strings.TrimSuffix(dlqTopic, policy.DLQSuffix)
+		var headers []kafka.Header
+		for _, header := range msg.Headers {
+			switch header.Key {
+			case HeaderOriginalTopic:
+				topic = string(header.Value)
+			case HeaderOriginalPartition, HeaderOriginalOffset, HeaderError, HeaderErrorClass, HeaderAttempts, HeaderFailedAt:
+			default:
+				headers = append(headers, header)
+			}
+		}
+		if topic == dlqTopic {
+			return replayed, fmt.Errorf("cannot determine the source topic of %s/%d/%d", msg.Topic, msg.Partition, msg.Offset)
+		}
+
+		if err := writer.WriteMessages(ctx, kafka.Message{
+			Topic:   topic,
+			Key:     msg.Key,
+			Value:   msg.Value,
+			Headers: headers,
+		}); err != nil {
+			return replayed, fmt.Errorf("error replaying message to %s: %w", topic, err)
+		}
+
+		if err := reader.CommitMessages(ctx, msg); err != nil {
+			return replayed, fmt.Errorf("error committing dead-lettered message: %w", err)
+		}
+		replayed++
+		log.Printf("replayed %s/%d/%d to %s", msg.Topic, msg.Partition, msg.Offset, topic)
+	}
+
+	return replayed, nil
+}
@@ -9,8 +9,8 @@ import (
 	"github.com/health-analytics-service/health-analytics-service/config"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
 	consumer "github.com/health-analytics-service/health-analytics-service/kafka"
+	"github.com/health-analytics-service/health-analytics-service/storage/memory"
 	redisDB "github.com/health-analytics-service/health-analytics-service/storage/redis"
-	"github.com/health-analytics-service/health-analytics-service/storage/test"
 	"github.com/segmentio/kafka-go"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -25,11 +25,8 @@ func TestGeneticDataConsumer(t *testing.T) {
 	createTopic(t, cfg.KafkaBrokersTest, topic)
 	// defer deleteTopic(t, cfg.KafkaBrokersTest, topic)
 
-	// Initialize MongoDB storage for testing
-	storage, err := test.NewMongoStorageTest(cfg)
-	if err != nil {
-		t.Fatalf("failed to initialize storage: %v", err)
-	}
+	// Initialize in-memory storage for testing
+	storage := memory.NewStorage()
 	redisCl, err := redisDB.Connect(&cfg)
 	assert.NoError(t, err, "Failed to connect redis")
 
@@ -50,7 +47,7 @@ func TestGeneticDataConsumer(t *testing.T) {
 		AnalysisDate: time.Now().Format("2006-01-02"),
 	}
 	// Create a GeneticDataConsumer with the test storage
-	consumer := consumer.NewGeneticDataConsumer(cfg.KafkaBrokersTest, topic, storage, redisCl)
+	consumer := consumer.NewGeneticDataConsumer(cfg.KafkaBrokersTest, topic, storage, redisCl, consumer.OptionsFromConfig(cfg, "test-genetic-data-group"))
 	// Consume the message
 	go func() {
 		if err := consumer.Consume(context.Background()); err != nil {
@@ -73,6 +70,133 @@ func TestGeneticDataConsumer(t *testing.T) {
 	assert.Equal(t, geneticDataModel.DataType, createdData.DataType)
 	assert.Equal(t, geneticDataModel.AnalysisDate, createdData.AnalysisDate)
 	assert.Equal(t, geneticDataModel.DataValue.String(), createdData.DataValue.String())
+
+	// Delete the genetic data through Kafka
+	produceMessage(t, cfg.KafkaBrokersTest, topic, "genetic_data.delete", map[string]string{
+		"id":      geneticDataModel.Id,
+		"user_id": geneticDataModel.UserId,
+	})
+	time.Sleep(time.Second * 4)
+
+	_, err = storage.GeneticData().GetGeneticData(context.Background(), geneticDataModel.Id)
+	assert.Error(t, err)
+
+	// Both mutations are audited
+	auditLog, err := storage.AuditLog().QueryAuditLog(context.Background(), &health.QueryAuditLogRequest{
+		ResourceType: "genetic_data",
+		ResourceId:   geneticDataModel.Id,
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, auditLog.Entries, 2) {
+		assert.Equal(t, "delete", auditLog.Entries[0].Action)
+		assert.Equal(t, "create", auditLog.Entries[1].Action)
+		for _, entry := range auditLog.Entries {
+			assert.Equal(t, "OK", entry.Outcome)
+			assert.Equal(t, "kafka", entry.Source)
+			assert.Equal(t, geneticDataModel.UserId, entry.UserId)
+		}
+	}
+}
+
+func TestGeneticDataConsumerDeleteOwnerMismatch(t *testing.T) {
+	cfg := config.Load()
+
+	topic := "test-genetic-data-owner-topic"
+	createTopic(t, cfg.KafkaBrokersTest, topic)
+
+	storage := memory.NewStorage()
+	redisCl, err := redisDB.Connect(&cfg)
+	assert.NoError(t, err, "Failed to connect redis")
+
+	dataValue, err := anypb.New(&health.MedicalRecord{RecordType: "Genetic Test"})
+	assert.NoError(t, err, "Failed to create Any proto message")
+	geneticData, err := storage.GeneticData().CreateGeneticData(context.Background(), &health.GeneticData{
+		Id:           primitive.NewObjectID().Hex(),
+		UserId:       "585b2a62-0943-4a69-99a5-d72c25df523c",
+		DataType:     "DNA Sequencing",
+		DataValue:    dataValue,
+		AnalysisDate: time.Now().Format("2006-01-02"),
+	})
+	assert.NoError(t, err)
+
+	consumer := consumer.NewGeneticDataConsumer(cfg.KafkaBrokersTest, topic, storage, redisCl, consumer.OptionsFromConfig(cfg, "test-genetic-data-owner-group"))
+	go func() {
+		if err := consumer.Consume(context.Background()); err != nil {
+			t.Errorf("Error consuming message: %v", err)
+		}
+	}()
+	time.Sleep(2 * time.Second)
+
+	// Another user cannot delete the genetic data
+	produceMessage(t, cfg.KafkaBrokersTest, topic, "genetic_data.delete", map[string]string{
+		"id":      geneticData.Id,
+		"user_id": "0b7f3c1e-2a6d-4f0e-9d8a-3c5b1e7f9a24",
+	})
+	time.Sleep(time.Second * 4)
+
+	_, err = storage.GeneticData().GetGeneticData(context.Background(), geneticData.Id)
+	assert.NoError(t, err)
+
+	// The rejected delete is audited as dead-lettered
+	auditLog, err := storage.AuditLog().QueryAuditLog(context.Background(), &health.QueryAuditLogRequest{
+		ResourceType: "genetic_data",
+		ResourceId:   geneticData.Id,
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, auditLog.Entries, 1) {
+		assert.Equal(t, "delete", auditLog.Entries[0].Action)
+		assert.Equal(t, "PermissionDenied", auditLog.Entries[0].Outcome)
+	}
+}
+
+func TestGeneticDataConsumerMaskedUpdate(t *testing.T) {
+	cfg := config.Load()
+
+	topic := "test-genetic-data-update-topic"
+	createTopic(t, cfg.KafkaBrokersTest, topic)
+
+	storage := memory.NewStorage()
+	redisCl, err := redisDB.Connect(&cfg)
+	assert.NoError(t, err, "Failed to connect redis")
+
+	dataValue, err := anypb.New(&health.MedicalRecord{RecordType: "Genetic Test"})
+	assert.NoError(t, err, "Failed to create Any proto message")
+	geneticData, err := storage.GeneticData().CreateGeneticData(context.Background(), &health.GeneticData{
+		Id:           primitive.NewObjectID().Hex(),
+		UserId:       primitive.NewObjectID().Hex(),
+		DataType:     "dna_sequencing",
+		DataValue:    dataValue,
+		AnalysisDate: time.Now().Format("2006-01-02"),
+	})
+	assert.NoError(t, err)
+
+	consumer := consumer.NewGeneticDataConsumer(cfg.KafkaBrokersTest, topic, storage, redisCl, consumer.OptionsFromConfig(cfg, "test-genetic-data-update-group"))
+	go func() {
+		if err := consumer.Consume(context.Background()); err != nil {
+			t.Errorf("Error consuming message: %v", err)
+		}
+	}()
+	time.Sleep(2 * time.Second)
+
+	// A masked update does not need to carry the user_id
+	produceMessage(t, cfg.KafkaBrokersTest, topic, "genetic_data.update", map[string]interface{}{
+		"id":          geneticData.Id,
+		"data_type":   "ancestry",
+		"update_mask": map[string][]string{"paths": {"data_type"}},
+	})
+	time.Sleep(time.Second * 4)
+
+	updated, err := storage.GeneticData().GetGeneticData(context.Background(), geneticData.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, "ancestry", updated.DataType)
+	assert.Equal(t, geneticData.UserId, updated.UserId)
+
+	// The owner is notified
+	notifications, err := redisCl.ListNotifications(context.Background(), &health.ListNotificationsRequest{UserId: geneticData.UserId})
+	assert.NoError(t, err)
+	if assert.Len(t, notifications.Notifications, 1) {
+		assert.Equal(t, "Your genetic data has been updated.", notifications.Notifications[0].Message)
+	}
 }
 
 // Helper functions to create, delete, and produce messages to a Kafka topic
@@ -2,67 +2,45 @@ package consumer
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 
+	"github.com/health-analytics-service/health-analytics-service/audit"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
 	"github.com/health-analytics-service/health-analytics-service/storage"
-	"github.com/segmentio/kafka-go"
+	"github.com/health-analytics-service/health-analytics-service/storage/redis"
+	"github.com/health-analytics-service/health-analytics-service/validation"
 )
 
 // WearableDataConsumer consumes Kafka messages related to wearable data.
-type WearableDataConsumer struct {
-	reader  *kafka.Reader
-	storage storage.StorageI
-}
+type WearableDataConsumer = Consumer[health.WearableData, *health.WearableData]
 
 // NewWearableDataConsumer creates a new WearableDataConsumer instance.
-func NewWearableDataConsumer(kafkaBrokers []string, topic string, storage storage.StorageI) *WearableDataConsumer {
-	reader := kafka.NewReader(kafka.ReaderConfig{
-		Brokers: kafkaBrokers,
-		Topic:   topic,
-		GroupID: "wearable-data-group", // Choose a suitable group ID
-	})
-	return &WearableDataConsumer{reader: reader, storage: storage}
-}
-
-// Consume starts consuming messages from the Kafka topic.
-func (c *WearableDataConsumer) Consume(ctx context.Context) error {
-	for {
-		msg, err := c.reader.FetchMessage(ctx)
-		if err != nil {
-			return fmt.Errorf("error fetching message: %w", err)
-		}
-
-		// Determine the message type based on the key
-		switch string(msg.Key) {
-		case "wearable_data.create":
-			var createModel health.WearableData
-			if err := json.Unmarshal(msg.Value, &createModel); err != nil {
-				log.Printf("error unmarshalling create wearable data message: %v", err)
-				continue
+func NewWearableDataConsumer(kafkaBrokers []string, topic string, storage storage.StorageI, redis *redis.Client, opts Options) *WearableDataConsumer {
+	repo := storage.WearableData()
+	return NewConsumer(kafkaBrokers, topic, opts, audit.NewLogger(storage.AuditLog()), map[string]Handler[*health.WearableData]{
+		"wearable_data.create": func(ctx context.Context, model *health.WearableData) (*health.WearableData, error) {
+			if err := validation.WearableData(model, validation.Create); err != nil {
+				return nil, fmt.Errorf("invalid wearable data: %w", err)
 			}
-			if _, err := c.storage.WearableData().CreateWearableData(ctx, &createModel); err != nil {
-				log.Printf("error creating wearable data: %v", err)
+			created, err := repo.CreateWearableData(ctx, model)
+			if err != nil {
+				return nil, fmt.Errorf("error creating wearable data: %w", err)
 			}
-
-		case "wearable_data.update":
-			var updateModel health.WearableData
-			if err := json.Unmarshal(msg.Value, &updateModel); err != nil {
-				log.Printf("error unmarshalling update wearable data message: %v", err)
-				continue
+			log.Printf("created wearable data %s for user %s", created.Id, created.UserId)
+			return created, nil
+		},
+		"wearable_data.update": func(ctx context.Context, model *health.WearableData) (*health.WearableData, error) {
+			if err := validation.WearableData(model, validation.Update); err != nil {
+				return nil, fmt.Errorf("invalid wearable data: %w", err)
 			}
-			if err := c.storage.WearableData().UpdateWearableData(ctx, &updateModel); err != nil {
-				log.Printf("error updating wearable data: %v", err)
+			updated, err := repo.UpdateWearableData(ctx, model)
+			if err != nil {
+				return nil, fmt.Errorf("error updating wearable data: %w", err)
 			}
-		default:
-			log.Printf("unknown message key: %s", msg.Key)
-		}
-
-		// Commit the message
-		if err := c.reader.CommitMessages(ctx, msg); err != nil {
-			return fmt.Errorf("error committing message: %w", err)
-		}
-	}
+			log.Printf("updated wearable data %s at %s", updated.Id, updated.UpdatedAt)
+			return updated, nil
+		},
+		"wearable_data.delete": deleteHandler[health.WearableData]("wearable data", repo.GetWearableData, repo.DeleteWearableData, redis, "Your wearable data has been deleted."),
+	})
 }
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_append_only();
+DROP TABLE IF EXISTS health_recommendations;
+DROP TABLE IF EXISTS wearable_data;
+DROP TABLE IF EXISTS lifestyle_data;
+DROP TABLE IF EXISTS genetic_data;
+DROP TABLE IF EXISTS medical_records_history;
+DROP TABLE IF EXISTS medical_records;
+DROP TABLE IF EXISTS data_keys;
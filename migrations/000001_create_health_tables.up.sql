@@ -0,0 +1,151 @@
+-- Ids are 24-character hexadecimal object ids, so that they are interchangeable with
+-- the MongoDB storage. Entities are soft-deleted (deleted_at is set) and every update
+-- increments their version.
+
+CREATE TABLE IF NOT EXISTS data_keys (
+    user_id     TEXT        NOT NULL,
+    version     INTEGER     NOT NULL,
+    kek_id      TEXT        NOT NULL,
+    wrapped_key BYTEA       NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, version)
+);
+
+-- Descriptions and attachments are encrypted; description_bidx is the blind index
+-- filtering descriptions by exact value.
+CREATE TABLE IF NOT EXISTS medical_records (
+    id               CHAR(24)    PRIMARY KEY,
+    user_id          TEXT        NOT NULL DEFAULT '',
+    record_type      TEXT        NOT NULL DEFAULT '',
+    record_date      DATE,
+    description      TEXT        NOT NULL DEFAULT '',
+    description_bidx TEXT        NOT NULL DEFAULT '',
+    doctor_id        TEXT        NOT NULL DEFAULT '',
+    attachments      TEXT[]      NOT NULL DEFAULT '{}',
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+    version          BIGINT      NOT NULL DEFAULT 1,
+    deleted_at       TIMESTAMPTZ,
+    deleted_by       TEXT
+);
+
+CREATE INDEX IF NOT EXISTS medical_records_user_id_created_at_idx ON medical_records (user_id, created_at) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS medical_records_description_bidx_idx ON medical_records (description_bidx);
+CREATE INDEX IF NOT EXISTS medical_records_deleted_at_idx ON medical_records (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Prior revisions of medical records, purged along with their record.
+CREATE TABLE IF NOT EXISTS medical_records_history (
+    record_id        CHAR(24)    NOT NULL REFERENCES medical_records (id) ON DELETE CASCADE,
+    version          BIGINT      NOT NULL,
+    user_id          TEXT        NOT NULL DEFAULT '',
+    record_type      TEXT        NOT NULL DEFAULT '',
+    record_date      DATE,
+    description      TEXT        NOT NULL DEFAULT '',
+    description_bidx TEXT        NOT NULL DEFAULT '',
+    doctor_id        TEXT        NOT NULL DEFAULT '',
+    attachments      TEXT[]      NOT NULL DEFAULT '{}',
+    created_at       TIMESTAMPTZ NOT NULL,
+    updated_at       TIMESTAMPTZ NOT NULL,
+    archived_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    archived_by      TEXT        NOT NULL DEFAULT '',
+    PRIMARY KEY (record_id, version)
+);
+
+-- Data values are encrypted, so the JSONB column holds the ciphertext as a JSON string.
+CREATE TABLE IF NOT EXISTS genetic_data (
+    id            CHAR(24)    PRIMARY KEY,
+    user_id       TEXT        NOT NULL DEFAULT '',
+    data_type     TEXT        NOT NULL DEFAULT '',
+    data_value    JSONB,
+    analysis_date DATE,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    version       BIGINT      NOT NULL DEFAULT 1,
+    deleted_at    TIMESTAMPTZ,
+    deleted_by    TEXT
+);
+
+CREATE INDEX IF NOT EXISTS genetic_data_user_id_created_at_idx ON genetic_data (user_id, created_at) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS genetic_data_deleted_at_idx ON genetic_data (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Data values are the protojson form of the payloads, tagged with their "@type".
+CREATE TABLE IF NOT EXISTS lifestyle_data (
+    id            CHAR(24)    PRIMARY KEY,
+    user_id       TEXT        NOT NULL DEFAULT '',
+    data_type     TEXT        NOT NULL DEFAULT '',
+    data_value    JSONB,
+    recorded_date DATE,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    version       BIGINT      NOT NULL DEFAULT 1,
+    deleted_at    TIMESTAMPTZ,
+    deleted_by    TEXT
+);
+
+CREATE INDEX IF NOT EXISTS lifestyle_data_user_id_created_at_idx ON lifestyle_data (user_id, created_at) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS lifestyle_data_deleted_at_idx ON lifestyle_data (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- value holds the numeric reading of the payload aggregated by summaries.
+CREATE TABLE IF NOT EXISTS wearable_data (
+    id                 CHAR(24)         PRIMARY KEY,
+    user_id            TEXT             NOT NULL DEFAULT '',
+    device_type        TEXT             NOT NULL DEFAULT '',
+    data_type          TEXT             NOT NULL DEFAULT '',
+    data_value         JSONB,
+    value              DOUBLE PRECISION,
+    recorded_timestamp TIMESTAMPTZ,
+    created_at         TIMESTAMPTZ      NOT NULL DEFAULT now(),
+    updated_at         TIMESTAMPTZ      NOT NULL DEFAULT now(),
+    version            BIGINT           NOT NULL DEFAULT 1,
+    deleted_at         TIMESTAMPTZ,
+    deleted_by         TEXT
+);
+
+CREATE INDEX IF NOT EXISTS wearable_data_user_id_created_at_idx ON wearable_data (user_id, created_at) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS wearable_data_user_id_recorded_timestamp_idx ON wearable_data (user_id, recorded_timestamp) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS wearable_data_deleted_at_idx ON wearable_data (deleted_at) WHERE deleted_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS health_recommendations (
+    id                  CHAR(24)    PRIMARY KEY,
+    user_id             TEXT        NOT NULL DEFAULT '',
+    recommendation_type TEXT        NOT NULL DEFAULT '',
+    description         TEXT        NOT NULL DEFAULT '',
+    priority            INTEGER     NOT NULL DEFAULT 0,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT now(),
+    version             BIGINT      NOT NULL DEFAULT 1,
+    deleted_at          TIMESTAMPTZ,
+    deleted_by          TEXT
+);
+
+CREATE INDEX IF NOT EXISTS health_recommendations_user_id_created_at_idx ON health_recommendations (user_id, created_at) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS health_recommendations_deleted_at_idx ON health_recommendations (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- The audit log is append-only: entries are inserted and queried, never updated or deleted.
+CREATE TABLE IF NOT EXISTS audit_log (
+    id            CHAR(24)    PRIMARY KEY,
+    actor         TEXT        NOT NULL DEFAULT '',
+    actor_roles   TEXT[]      NOT NULL DEFAULT '{}',
+    action        TEXT        NOT NULL DEFAULT '',
+    resource_type TEXT        NOT NULL DEFAULT '',
+    resource_id   TEXT        NOT NULL DEFAULT '',
+    user_id       TEXT        NOT NULL DEFAULT '',
+    outcome       TEXT        NOT NULL DEFAULT '',
+    source        TEXT        NOT NULL DEFAULT '',
+    method        TEXT        NOT NULL DEFAULT '',
+    error         TEXT        NOT NULL DEFAULT '',
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_user_id_created_at_idx ON audit_log (user_id, created_at);
+CREATE INDEX IF NOT EXISTS audit_log_created_at_idx ON audit_log (created_at);
+
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_log_append_only ON audit_log;
+CREATE TRIGGER audit_log_append_only BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
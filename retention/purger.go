@@ -0,0 +1,59 @@
+// Package retention permanently removes soft-deleted health data once its retention
+// period has passed.
+package retention
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/config"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+)
+
+// Purger periodically purges the data deleted for longer than the retention period.
+type Purger struct {
+	repo     storage.RetentionRepoI
+	period   time.Duration
+	interval time.Duration
+}
+
+// NewPurger creates a Purger removing data deleted more than period ago, every interval.
+func NewPurger(repo storage.RetentionRepoI, period, interval time.Duration) *Purger {
+	return &Purger{repo: repo, period: period, interval: interval}
+}
+
+// PurgerFromConfig creates a Purger from the retention settings of the configuration.
+func PurgerFromConfig(cfg config.Config, repo storage.RetentionRepoI) *Purger {
+	return NewPurger(repo, cfg.DeletedRetention, cfg.RetentionPurgeInterval)
+}
+
+// Run purges once, then every interval until ctx is cancelled. Failures are logged
+// and retried at the next interval. Nothing is purged when the retention period or
+// the interval is not positive.
+func (p *Purger) Run(ctx context.Context) {
+	if p.period <= 0 || p.interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		purged, err := p.Purge(ctx)
+		if err != nil {
+			log.Printf("failed to purge deleted data: %v", err)
+		} else if purged > 0 {
+			log.Printf("purged %d record(s) deleted more than %s ago", purged, p.period)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge permanently removes the data deleted more than the retention period ago.
+func (p *Purger) Purge(ctx context.Context) (int64, error) {
+	return p.repo.PurgeDeleted(ctx, time.Now().Add(-p.period))
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+)
+
+// AuditService implements the health.AuditServiceServer interface.
+type AuditService struct {
+	storage storage.StorageI
+	health.UnimplementedAuditServiceServer
+}
+
+// NewAuditService creates a new AuditService instance.
+func NewAuditService(storage storage.StorageI) *AuditService {
+	return &AuditService{
+		storage: storage,
+	}
+}
+
+// QueryAuditLog retrieves a page of audit entries, newest first.
+func (s *AuditService) QueryAuditLog(ctx context.Context, req *health.QueryAuditLogRequest) (*health.QueryAuditLogResponse, error) {
+	response, err := s.storage.AuditLog().QueryAuditLog(ctx, req)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to query audit log")
+	}
+
+	return response, nil
+}
@@ -2,11 +2,12 @@ package service
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/health-analytics-service/health-analytics-service/errs"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
 	"github.com/health-analytics-service/health-analytics-service/storage"
 	"github.com/health-analytics-service/health-analytics-service/storage/redis"
+	"github.com/health-analytics-service/health-analytics-service/validation"
 )
 
 // GeneticDataService implements the health.GeneticDataServiceServer interface.
@@ -24,55 +25,69 @@ func NewGeneticDataService(storage storage.StorageI) *GeneticDataService {
 }
 
 // CreateGeneticData creates a new genetic data record.
-func (s *GeneticDataService) CreateGeneticData(ctx context.Context, req *health.GeneticData) (*health.Empty, error) {
-	createdID, err := s.storage.GeneticData().CreateGeneticData(ctx, req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create genetic data: %w", err)
+func (s *GeneticDataService) CreateGeneticData(ctx context.Context, req *health.GeneticData) (*health.GeneticData, error) {
+	if err := validation.GeneticData(req, validation.Create); err != nil {
+		return nil, err
 	}
 
-	fmt.Println("Created Genetic Data with ID:", createdID) // Optional: Print the created ID
+	created, err := s.storage.GeneticData().CreateGeneticData(ctx, req)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to create genetic data")
+	}
 
-	return &health.Empty{}, nil
+	return created, nil
 }
 
 // GetGeneticData retrieves a genetic data record by its ID.
 func (s *GeneticDataService) GetGeneticData(ctx context.Context, req *health.ByIdRequest) (*health.GeneticData, error) {
 	data, err := s.storage.GeneticData().GetGeneticData(ctx, req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get genetic data: %w", err)
+		return nil, errs.Wrap(err, "failed to get genetic data")
 	}
 
 	return data, nil
 }
 
 // UpdateGeneticData updates an existing genetic data record.
-func (s *GeneticDataService) UpdateGeneticData(ctx context.Context, req *health.GeneticData) (*health.Empty, error) {
-	err := s.storage.GeneticData().UpdateGeneticData(ctx, req)
+func (s *GeneticDataService) UpdateGeneticData(ctx context.Context, req *health.GeneticData) (*health.GeneticData, error) {
+	if err := validation.GeneticData(req, validation.Update); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.storage.GeneticData().UpdateGeneticData(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update genetic data: %w", err)
+		return nil, errs.Wrap(err, "failed to update genetic data")
 	}
 
-	return &health.Empty{}, nil
+	return updated, nil
 }
 
 // DeleteGeneticData deletes a genetic data record by its ID.
 func (s *GeneticDataService) DeleteGeneticData(ctx context.Context, req *health.ByIdRequest) (*health.Empty, error) {
 	err := s.storage.GeneticData().DeleteGeneticData(ctx, req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete genetic data: %w", err)
+		return nil, errs.Wrap(err, "failed to delete genetic data")
 	}
 
 	return &health.Empty{}, nil
 }
 
+// RestoreGeneticData restores deleted genetic data by its ID.
+func (s *GeneticDataService) RestoreGeneticData(ctx context.Context, req *health.ByIdRequest) (*health.GeneticData, error) {
+	restored, err := s.storage.GeneticData().RestoreGeneticData(ctx, req.Id)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to restore genetic data")
+	}
+
+	return restored, nil
+}
+
 // ListGeneticData retrieves a list of genetic data records based on the provided request.
 func (s *GeneticDataService) ListGeneticData(ctx context.Context, req *health.ListGeneticDataRequest) (*health.ListGeneticDataResponse, error) {
-	data, err := s.storage.GeneticData().ListGeneticData(ctx, req)
+	response, err := s.storage.GeneticData().ListGeneticData(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list genetic data: %w", err)
+		return nil, errs.Wrap(err, "failed to list genetic data")
 	}
 
-	return &health.ListGeneticDataResponse{
-		GeneticData: data,
-	}, nil
+	return response, nil
 }
@@ -2,11 +2,12 @@ package service
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-redis/redis"
+	"github.com/health-analytics-service/health-analytics-service/errs"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
 	"github.com/health-analytics-service/health-analytics-service/storage"
+	"github.com/health-analytics-service/health-analytics-service/validation"
 )
 
 // HealthRecommendationService implements the health.HealthRecommendationServiceServer interface.
@@ -24,55 +25,69 @@ func NewHealthRecommendationService(storage storage.StorageI) *HealthRecommendat
 }
 
 // CreateHealthRecommendation creates a new health recommendation.
-func (s *HealthRecommendationService) CreateHealthRecommendation(ctx context.Context, req *health.HealthRecommendation) (*health.Empty, error) {
-	createdID, err := s.storage.HealthRecommendation().CreateHealthRecommendation(ctx, req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create health recommendation: %w", err)
+func (s *HealthRecommendationService) CreateHealthRecommendation(ctx context.Context, req *health.HealthRecommendation) (*health.HealthRecommendation, error) {
+	if err := validation.HealthRecommendation(req, validation.Create); err != nil {
+		return nil, err
 	}
 
-	fmt.Println("Created Health Recommendation with ID:", createdID) // Optional: Print the created ID
+	created, err := s.storage.HealthRecommendation().CreateHealthRecommendation(ctx, req)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to create health recommendation")
+	}
 
-	return &health.Empty{}, nil
+	return created, nil
 }
 
 // GetHealthRecommendation retrieves a health recommendation by its ID.
 func (s *HealthRecommendationService) GetHealthRecommendation(ctx context.Context, req *health.ByIdRequest) (*health.HealthRecommendation, error) {
 	recommendation, err := s.storage.HealthRecommendation().GetHealthRecommendation(ctx, req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get health recommendation: %w", err)
+		return nil, errs.Wrap(err, "failed to get health recommendation")
 	}
 
 	return recommendation, nil
 }
 
 // UpdateHealthRecommendation updates an existing health recommendation.
-func (s *HealthRecommendationService) UpdateHealthRecommendation(ctx context.Context, req *health.HealthRecommendation) (*health.Empty, error) {
-	err := s.storage.HealthRecommendation().UpdateHealthRecommendation(ctx, req)
+func (s *HealthRecommendationService) UpdateHealthRecommendation(ctx context.Context, req *health.HealthRecommendation) (*health.HealthRecommendation, error) {
+	if err := validation.HealthRecommendation(req, validation.Update); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.storage.HealthRecommendation().UpdateHealthRecommendation(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update health recommendation: %w", err)
+		return nil, errs.Wrap(err, "failed to update health recommendation")
 	}
 
-	return &health.Empty{}, nil
+	return updated, nil
 }
 
 // DeleteHealthRecommendation deletes a health recommendation by its ID.
 func (s *HealthRecommendationService) DeleteHealthRecommendation(ctx context.Context, req *health.ByIdRequest) (*health.Empty, error) {
 	err := s.storage.HealthRecommendation().DeleteHealthRecommendation(ctx, req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete health recommendation: %w", err)
+		return nil, errs.Wrap(err, "failed to delete health recommendation")
 	}
 
 	return &health.Empty{}, nil
 }
 
+// RestoreHealthRecommendation restores a deleted health recommendation by its ID.
+func (s *HealthRecommendationService) RestoreHealthRecommendation(ctx context.Context, req *health.ByIdRequest) (*health.HealthRecommendation, error) {
+	restored, err := s.storage.HealthRecommendation().RestoreHealthRecommendation(ctx, req.Id)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to restore health recommendation")
+	}
+
+	return restored, nil
+}
+
 // ListHealthRecommendations retrieves a list of health recommendations based on the provided request.
 func (s *HealthRecommendationService) ListHealthRecommendations(ctx context.Context, req *health.ListHealthRecommendationsRequest) (*health.ListHealthRecommendationsResponse, error) {
-	recommendations, err := s.storage.HealthRecommendation().ListHealthRecommendations(ctx, req)
+	response, err := s.storage.HealthRecommendation().ListHealthRecommendations(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list health recommendations: %w", err)
+		return nil, errs.Wrap(err, "failed to list health recommendations")
 	}
 
-	return &health.ListHealthRecommendationsResponse{
-		HealthRecommendations: recommendations,
-	}, nil
+	return response, nil
 }
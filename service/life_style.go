@@ -2,11 +2,12 @@ package service
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-redis/redis"
+	"github.com/health-analytics-service/health-analytics-service/errs"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
 	"github.com/health-analytics-service/health-analytics-service/storage"
+	"github.com/health-analytics-service/health-analytics-service/validation"
 )
 
 // LifestyleDataService implements the health.LifestyleDataServiceServer interface.
@@ -24,55 +25,69 @@ func NewLifestyleDataService(storage storage.StorageI) *LifestyleDataService {
 }
 
 // CreateLifestyleData creates a new lifestyle data record.
-func (s *LifestyleDataService) CreateLifestyleData(ctx context.Context, req *health.LifestyleData) (*health.Empty, error) {
-	createdID, err := s.storage.LifestyleData().CreateLifestyleData(ctx, req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create lifestyle data: %w", err)
+func (s *LifestyleDataService) CreateLifestyleData(ctx context.Context, req *health.LifestyleData) (*health.LifestyleData, error) {
+	if err := validation.LifestyleData(req, validation.Create); err != nil {
+		return nil, err
 	}
 
-	fmt.Println("Created Lifestyle Data with ID:", createdID) // Optional: Print the created ID
+	created, err := s.storage.LifestyleData().CreateLifestyleData(ctx, req)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to create lifestyle data")
+	}
 
-	return &health.Empty{}, nil
+	return created, nil
 }
 
 // GetLifestyleData retrieves a lifestyle data record by its ID.
 func (s *LifestyleDataService) GetLifestyleData(ctx context.Context, req *health.ByIdRequest) (*health.LifestyleData, error) {
 	data, err := s.storage.LifestyleData().GetLifestyleData(ctx, req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get lifestyle data: %w", err)
+		return nil, errs.Wrap(err, "failed to get lifestyle data")
 	}
 
 	return data, nil
 }
 
 // UpdateLifestyleData updates an existing lifestyle data record.
-func (s *LifestyleDataService) UpdateLifestyleData(ctx context.Context, req *health.LifestyleData) (*health.Empty, error) {
-	err := s.storage.LifestyleData().UpdateLifestyleData(ctx, req)
+func (s *LifestyleDataService) UpdateLifestyleData(ctx context.Context, req *health.LifestyleData) (*health.LifestyleData, error) {
+	if err := validation.LifestyleData(req, validation.Update); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.storage.LifestyleData().UpdateLifestyleData(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update lifestyle data: %w", err)
+		return nil, errs.Wrap(err, "failed to update lifestyle data")
 	}
 
-	return &health.Empty{}, nil
+	return updated, nil
 }
 
 // DeleteLifestyleData deletes a lifestyle data record by its ID.
 func (s *LifestyleDataService) DeleteLifestyleData(ctx context.Context, req *health.ByIdRequest) (*health.Empty, error) {
 	err := s.storage.LifestyleData().DeleteLifestyleData(ctx, req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete lifestyle data: %w", err)
+		return nil, errs.Wrap(err, "failed to delete lifestyle data")
 	}
 
 	return &health.Empty{}, nil
 }
 
+// RestoreLifestyleData restores deleted lifestyle data by its ID.
+func (s *LifestyleDataService) RestoreLifestyleData(ctx context.Context, req *health.ByIdRequest) (*health.LifestyleData, error) {
+	restored, err := s.storage.LifestyleData().RestoreLifestyleData(ctx, req.Id)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to restore lifestyle data")
+	}
+
+	return restored, nil
+}
+
 // ListLifestyleData retrieves a list of lifestyle data records based on the provided request.
 func (s *LifestyleDataService) ListLifestyleData(ctx context.Context, req *health.ListLifestyleDataRequest) (*health.ListLifestyleDataResponse, error) {
-	data, err := s.storage.LifestyleData().ListLifestyleData(ctx, req)
+	response, err := s.storage.LifestyleData().ListLifestyleData(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list lifestyle data: %w", err)
+		return nil, errs.Wrap(err, "failed to list lifestyle data")
 	}
 
-	return &health.ListLifestyleDataResponse{
-		LifestyleData: data,
-	}, nil
+	return response, nil
 }
@@ -2,11 +2,12 @@ package service
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-redis/redis"
+	"github.com/health-analytics-service/health-analytics-service/errs"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
 	"github.com/health-analytics-service/health-analytics-service/storage"
+	"github.com/health-analytics-service/health-analytics-service/validation"
 )
 
 // MedicalRecordService implements the health.MedicalRecordServiceServer interface.
@@ -24,55 +25,89 @@ func NewMedicalRecordService(storage storage.StorageI) *MedicalRecordService {
 }
 
 // CreateMedicalRecord creates a new medical record.
-func (s *MedicalRecordService) CreateMedicalRecord(ctx context.Context, req *health.MedicalRecord) (*health.Empty, error) {
-	createdID, err := s.storage.MedicalRecord().CreateMedicalRecord(ctx, req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create medical record: %w", err)
+func (s *MedicalRecordService) CreateMedicalRecord(ctx context.Context, req *health.MedicalRecord) (*health.MedicalRecord, error) {
+	if err := validation.MedicalRecord(req, validation.Create); err != nil {
+		return nil, err
 	}
 
-	fmt.Println("Created Medical Record with ID:", createdID) // Optional: Print the created ID
+	created, err := s.storage.MedicalRecord().CreateMedicalRecord(ctx, req)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to create medical record")
+	}
 
-	return &health.Empty{}, nil
+	return created, nil
 }
 
 // GetMedicalRecord retrieves a medical record by its ID.
 func (s *MedicalRecordService) GetMedicalRecord(ctx context.Context, req *health.ByIdRequest) (*health.MedicalRecord, error) {
 	record, err := s.storage.MedicalRecord().GetMedicalRecord(ctx, req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get medical record: %w", err)
+		return nil, errs.Wrap(err, "failed to get medical record")
 	}
 
 	return record, nil
 }
 
 // UpdateMedicalRecord updates an existing medical record.
-func (s *MedicalRecordService) UpdateMedicalRecord(ctx context.Context, req *health.MedicalRecord) (*health.Empty, error) {
-	err := s.storage.MedicalRecord().UpdateMedicalRecord(ctx, req)
+func (s *MedicalRecordService) UpdateMedicalRecord(ctx context.Context, req *health.MedicalRecord) (*health.MedicalRecord, error) {
+	if err := validation.MedicalRecord(req, validation.Update); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.storage.MedicalRecord().UpdateMedicalRecord(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update medical record: %w", err)
+		return nil, errs.Wrap(err, "failed to update medical record")
 	}
 
-	return &health.Empty{}, nil
+	return updated, nil
 }
 
 // DeleteMedicalRecord deletes a medical record by its ID.
 func (s *MedicalRecordService) DeleteMedicalRecord(ctx context.Context, req *health.ByIdRequest) (*health.Empty, error) {
 	err := s.storage.MedicalRecord().DeleteMedicalRecord(ctx, req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete medical record: %w", err)
+		return nil, errs.Wrap(err, "failed to delete medical record")
 	}
 
 	return &health.Empty{}, nil
 }
 
+// RestoreMedicalRecord restores a deleted medical record by its ID.
+func (s *MedicalRecordService) RestoreMedicalRecord(ctx context.Context, req *health.ByIdRequest) (*health.MedicalRecord, error) {
+	restored, err := s.storage.MedicalRecord().RestoreMedicalRecord(ctx, req.Id)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to restore medical record")
+	}
+
+	return restored, nil
+}
+
 // ListMedicalRecords retrieves a list of medical records based on the provided request.
 func (s *MedicalRecordService) ListMedicalRecords(ctx context.Context, req *health.ListMedicalRecordsRequest) (*health.ListMedicalRecordsResponse, error) {
-	records, err := s.storage.MedicalRecord().ListMedicalRecords(ctx, req)
+	response, err := s.storage.MedicalRecord().ListMedicalRecords(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list medical records: %w", err)
+		return nil, errs.Wrap(err, "failed to list medical records")
 	}
 
-	return &health.ListMedicalRecordsResponse{
-		MedicalRecords: records,
-	}, nil
+	return response, nil
+}
+
+// ListMedicalRecordVersions retrieves the prior revisions of a medical record.
+func (s *MedicalRecordService) ListMedicalRecordVersions(ctx context.Context, req *health.ListMedicalRecordVersionsRequest) (*health.ListMedicalRecordVersionsResponse, error) {
+	response, err := s.storage.MedicalRecord().ListMedicalRecordVersions(ctx, req)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list medical record versions")
+	}
+
+	return response, nil
+}
+
+// GetMedicalRecordVersion retrieves a revision of a medical record.
+func (s *MedicalRecordService) GetMedicalRecordVersion(ctx context.Context, req *health.GetMedicalRecordVersionRequest) (*health.MedicalRecord, error) {
+	record, err := s.storage.MedicalRecord().GetMedicalRecordVersion(ctx, req.Id, req.Version)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get medical record version")
+	}
+
+	return record, nil
 }
@@ -2,8 +2,8 @@ package service
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/health-analytics-service/health-analytics-service/errs"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
 	"github.com/health-analytics-service/health-analytics-service/storage"
 )
@@ -26,7 +26,7 @@ func NewHealthMonitoringService(storage storage.StorageI) *HealthMonitoringServi
 func (s *HealthMonitoringService) GetDailySummary(ctx context.Context, req *health.DailySummaryRequest) (*health.SummaryResponse, error) {
 	summary, err := s.storage.HealthMonitoring().GetDailySummary(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get daily summary: %w", err)
+		return nil, errs.Wrap(err, "failed to get daily summary")
 	}
 
 	return summary, nil
@@ -36,7 +36,7 @@ func (s *HealthMonitoringService) GetDailySummary(ctx context.Context, req *heal
 func (s *HealthMonitoringService) GetWeeklySummary(ctx context.Context, req *health.WeeklySummaryRequest) (*health.SummaryResponse, error) {
 	summary, err := s.storage.HealthMonitoring().GetWeeklySummary(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get weekly summary: %w", err)
+		return nil, errs.Wrap(err, "failed to get weekly summary")
 	}
 
 	return summary, nil
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage/redis"
+)
+
+// NotificationService implements the health.NotificationServiceServer interface.
+type NotificationService struct {
+	redisClient *redis.Client
+	health.UnimplementedNotificationServiceServer
+}
+
+// NewNotificationService creates a new NotificationService instance.
+func NewNotificationService(redisClient *redis.Client) *NotificationService {
+	return &NotificationService{
+		redisClient: redisClient,
+	}
+}
+
+// ListNotifications retrieves a page of a user's notifications, newest first.
+func (s *NotificationService) ListNotifications(ctx context.Context, req *health.ListNotificationsRequest) (*health.ListNotificationsResponse, error) {
+	if req.UserId == "" {
+		return nil, errs.InvalidArgument("user_id", "user_id is required")
+	}
+
+	response, err := s.redisClient.ListNotifications(ctx, req)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list notifications")
+	}
+
+	return response, nil
+}
+
+// GetUnreadCount returns the number of unread notifications of a user.
+func (s *NotificationService) GetUnreadCount(ctx context.Context, req *health.UnreadCountRequest) (*health.UnreadCountResponse, error) {
+	if req.UserId == "" {
+		return nil, errs.InvalidArgument("user_id", "user_id is required")
+	}
+
+	count, err := s.redisClient.GetUnreadCount(ctx, req.UserId)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to count unread notifications")
+	}
+
+	return &health.UnreadCountResponse{Count: count}, nil
+}
+
+// MarkRead marks notifications of a user as read.
+func (s *NotificationService) MarkRead(ctx context.Context, req *health.MarkReadRequest) (*health.MarkReadResponse, error) {
+	if req.UserId == "" {
+		return nil, errs.InvalidArgument("user_id", "user_id is required")
+	}
+
+	updated, err := s.redisClient.MarkRead(ctx, req.UserId, req.Ids)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to mark notifications as read")
+	}
+
+	return &health.MarkReadResponse{Updated: updated}, nil
+}
+
+// MarkAllRead marks all notifications of a user as read.
+func (s *NotificationService) MarkAllRead(ctx context.Context, req *health.MarkAllReadRequest) (*health.MarkReadResponse, error) {
+	if req.UserId == "" {
+		return nil, errs.InvalidArgument("user_id", "user_id is required")
+	}
+
+	updated, err := s.redisClient.MarkAllRead(ctx, req.UserId)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to mark notifications as read")
+	}
+
+	return &health.MarkReadResponse{Updated: updated}, nil
+}
+
+// DeleteNotification deletes a notification of a user.
+func (s *NotificationService) DeleteNotification(ctx context.Context, req *health.DeleteNotificationRequest) (*health.Empty, error) {
+	if req.UserId == "" {
+		return nil, errs.InvalidArgument("user_id", "user_id is required")
+	}
+	if req.Id == "" {
+		return nil, errs.InvalidArgument("id", "id is required")
+	}
+
+	if err := s.redisClient.DeleteNotification(ctx, req.UserId, req.Id); err != nil {
+		return nil, errs.Wrap(err, "failed to delete notification")
+	}
+
+	return &health.Empty{}, nil
+}
+
+// SubscribeNotifications streams the notifications of a user as they are created,
+// after replaying the ones created since last_seen_id. The stream ends when the
+// client disconnects.
+func (s *NotificationService) SubscribeNotifications(req *health.SubscribeNotificationsRequest, stream health.NotificationService_SubscribeNotificationsServer) error {
+	if req.UserId == "" {
+		return errs.InvalidArgument("user_id", "user_id is required")
+	}
+
+	ctx := stream.Context()
+	err := s.redisClient.StreamNotifications(ctx, req.UserId, req.LastSeenId, stream.Send)
+	if ctx.Err() != nil {
+		// The client went away
+		return nil
+	}
+	if err != nil {
+		return errs.Wrap(err, "failed to stream notifications")
+	}
+
+	return nil
+}
@@ -2,11 +2,12 @@ package service
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-redis/redis"
+	"github.com/health-analytics-service/health-analytics-service/errs"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
 	"github.com/health-analytics-service/health-analytics-service/storage"
+	"github.com/health-analytics-service/health-analytics-service/validation"
 )
 
 // WearableDataService implements the health.WearableDataServiceServer interface.
@@ -24,55 +25,69 @@ func NewWearableDataService(storage storage.StorageI) *WearableDataService {
 }
 
 // CreateWearableData creates a new wearable data record.
-func (s *WearableDataService) CreateWearableData(ctx context.Context, req *health.WearableData) (*health.Empty, error) {
-	createdID, err := s.storage.WearableData().CreateWearableData(ctx, req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create wearable data: %w", err)
+func (s *WearableDataService) CreateWearableData(ctx context.Context, req *health.WearableData) (*health.WearableData, error) {
+	if err := validation.WearableData(req, validation.Create); err != nil {
+		return nil, err
 	}
 
-	fmt.Println("Created Wearable Data with ID:", createdID) // Optional: Print the created ID
+	created, err := s.storage.WearableData().CreateWearableData(ctx, req)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to create wearable data")
+	}
 
-	return &health.Empty{}, nil
+	return created, nil
 }
 
 // GetWearableData retrieves a wearable data record by its ID.
 func (s *WearableDataService) GetWearableData(ctx context.Context, req *health.ByIdRequest) (*health.WearableData, error) {
 	data, err := s.storage.WearableData().GetWearableData(ctx, req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get wearable data: %w", err)
+		return nil, errs.Wrap(err, "failed to get wearable data")
 	}
 
 	return data, nil
 }
 
 // UpdateWearableData updates an existing wearable data record.
-func (s *WearableDataService) UpdateWearableData(ctx context.Context, req *health.WearableData) (*health.Empty, error) {
-	err := s.storage.WearableData().UpdateWearableData(ctx, req)
+func (s *WearableDataService) UpdateWearableData(ctx context.Context, req *health.WearableData) (*health.WearableData, error) {
+	if err := validation.WearableData(req, validation.Update); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.storage.WearableData().UpdateWearableData(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update wearable data: %w", err)
+		return nil, errs.Wrap(err, "failed to update wearable data")
 	}
 
-	return &health.Empty{}, nil
+	return updated, nil
 }
 
 // DeleteWearableData deletes a wearable data record by its ID.
 func (s *WearableDataService) DeleteWearableData(ctx context.Context, req *health.ByIdRequest) (*health.Empty, error) {
 	err := s.storage.WearableData().DeleteWearableData(ctx, req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete wearable data: %w", err)
+		return nil, errs.Wrap(err, "failed to delete wearable data")
 	}
 
 	return &health.Empty{}, nil
 }
 
+// RestoreWearableData restores deleted wearable data by its ID.
+func (s *WearableDataService) RestoreWearableData(ctx context.Context, req *health.ByIdRequest) (*health.WearableData, error) {
+	restored, err := s.storage.WearableData().RestoreWearableData(ctx, req.Id)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to restore wearable data")
+	}
+
+	return restored, nil
+}
+
 // ListWearableData retrieves a list of wearable data records based on the provided request.
 func (s *WearableDataService) ListWearableData(ctx context.Context, req *health.ListWearableDataRequest) (*health.ListWearableDataResponse, error) {
-	data, err := s.storage.WearableData().ListWearableData(ctx, req)
+	response, err := s.storage.WearableData().ListWearableData(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list wearable data: %w", err)
+		return nil, errs.Wrap(err, "failed to list wearable data")
 	}
 
-	return &health.ListWearableDataResponse{
-		WearableData: data,
-	}, nil
+	return response, nil
 }
@@ -0,0 +1,31 @@
+package storage
+
+import "context"
+
+type actorKey struct{}
+
+type includeDeletedKey struct{}
+
+// WithActor returns a copy of ctx carrying the user on whose behalf data is modified,
+// recorded by the repositories (e.g. as the user who deleted an entity).
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor carried by ctx, or "" when unknown.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// WithDeleted returns a copy of ctx in which Get methods also return soft-deleted
+// entities. Lists and summaries always exclude them.
+func WithDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedKey{}, true)
+}
+
+// IncludesDeleted reports whether soft-deleted entities are returned in ctx.
+func IncludesDeleted(ctx context.Context) bool {
+	include, _ := ctx.Value(includeDeletedKey{}).(bool)
+	return include
+}
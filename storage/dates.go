@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+)
+
+// DateLayout is the format of calendar date fields (record_date, analysis_date, recorded_date).
+const DateLayout = "2006-01-02"
+
+// TimestampLayout is the format of timestamp fields (recorded_timestamp): RFC3339 in
+// UTC with the milliseconds stored, omitted when zero.
+const TimestampLayout = "2006-01-02T15:04:05.999Z07:00"
+
+// ParseDate parses a calendar date (YYYY-MM-DD, or an RFC3339 timestamp truncated
+// to its day) into midnight UTC.
+func ParseDate(field, value string) (time.Time, error) {
+	if date, err := time.Parse(DateLayout, value); err == nil {
+		return date, nil
+	}
+	if ts, err := time.Parse(time.RFC3339, value); err == nil {
+		return time.Date(ts.Year(), ts.Month(), ts.Day(), 0, 0, 0, 0, time.UTC), nil
+	}
+	return time.Time{}, errs.InvalidArgument(field, "invalid %s %q: expected YYYY-MM-DD", field, value)
+}
+
+// ParseTimestamp parses an RFC3339 timestamp (or a YYYY-MM-DD date as midnight UTC),
+// truncated to the milliseconds kept by the storages.
+func ParseTimestamp(field, value string) (time.Time, error) {
+	if ts, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return ts.UTC().Truncate(time.Millisecond), nil
+	}
+	if date, err := time.Parse(DateLayout, value); err == nil {
+		return date, nil
+	}
+	return time.Time{}, errs.InvalidArgument(field, "invalid %s %q: expected an RFC3339 timestamp", field, value)
+}
@@ -0,0 +1,17 @@
+package storage
+
+import "google.golang.org/protobuf/types/known/fieldmaskpb"
+
+// MaskIncludes reports whether an update with the given update_mask writes the field
+// at path. Updates without a mask (or with an empty one) write every field.
+func MaskIncludes(mask *fieldmaskpb.FieldMask, path string) bool {
+	if len(mask.GetPaths()) == 0 {
+		return true
+	}
+	for _, p := range mask.GetPaths() {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
+)
+
+// AuditLogRepo implements the storage.AuditLogRepoI interface in memory. Entries are
+// appended and queried, never updated or deleted.
+type AuditLogRepo struct {
+	mu      sync.RWMutex
+	entries []auditEntry
+}
+
+// auditEntry is an appended audit entry along with its sort keys.
+type auditEntry struct {
+	key   sortKey
+	entry *health.AuditEntry
+}
+
+// AppendAuditEntry appends an entry to the audit log. The entry is stamped with the
+// current time unless it carries its own timestamp.
+func (r *AuditLogRepo) AppendAuditEntry(ctx context.Context, entry *health.AuditEntry) error {
+	timestamp := now()
+	if entry.Timestamp != "" {
+		ts, err := storage.ParseTimestamp("timestamp", entry.Timestamp)
+		if err != nil {
+			return err
+		}
+		timestamp = ts
+	}
+
+	stored := proto.Clone(entry).(*health.AuditEntry)
+	id := primitive.NewObjectID()
+	stored.Id = id.Hex()
+	stored.Timestamp = timestamp.UTC().Format(storage.TimestampLayout)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, auditEntry{key: sortKey{createdAt: timestamp, id: id}, entry: stored})
+	return nil
+}
+
+// QueryAuditLog returns a page of audit entries matching the filters, newest first.
+func (r *AuditLogRepo) QueryAuditLog(ctx context.Context, req *health.QueryAuditLogRequest) (*health.QueryAuditLogResponse, error) {
+	var from, to time.Time
+	if req.From != "" {
+		ts, err := storage.ParseTimestamp("created_at_from", req.From)
+		if err != nil {
+			return nil, err
+		}
+		from = ts
+	}
+	if req.To != "" {
+		ts, err := storage.ParseTimestamp("created_at_to", req.To)
+		if err != nil {
+			return nil, err
+		}
+		to = ts
+	}
+	page, err := newPageRequest(req.PageSize, req.PageToken, "")
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	var entries []auditEntry
+	for _, e := range r.entries {
+		if (req.UserId == "" || e.entry.UserId == req.UserId) &&
+			(req.Actor == "" || e.entry.Actor == req.Actor) &&
+			(req.ResourceType == "" || e.entry.ResourceType == req.ResourceType) &&
+			(req.ResourceId == "" || e.entry.ResourceId == req.ResourceId) &&
+			(from.IsZero() || !e.key.createdAt.Before(from)) &&
+			(to.IsZero() || e.key.createdAt.Before(to)) {
+			entries = append(entries, e)
+		}
+	}
+	r.mu.RUnlock()
+
+	response := &health.QueryAuditLogResponse{TotalSize: int64(len(entries))}
+	entries, response.NextPageToken, err = paginate(page, entries, func(e auditEntry) sortKey { return e.key })
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		response.Entries = append(response.Entries, proto.Clone(e.entry).(*health.AuditEntry))
+	}
+	return response, nil
+}
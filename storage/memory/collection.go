@@ -0,0 +1,258 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
+)
+
+// entity is a health entity stored in a collection.
+type entity interface {
+	proto.Message
+	GetUserId() string
+}
+
+// document is a stored entity along with the fields the storage maintains itself.
+type document[T entity] struct {
+	id        primitive.ObjectID
+	entity    T // The fields written by creates and updates
+	createdAt time.Time
+	updatedAt time.Time
+	version   int64
+	deletedAt time.Time // Zero unless soft-deleted
+	deletedBy string
+	history   []T // Prior revisions, oldest first, for collections keeping them
+}
+
+func (d *document[T]) deleted() bool {
+	return !d.deletedAt.IsZero()
+}
+
+func (d *document[T]) key() sortKey {
+	return sortKey{createdAt: d.createdAt, id: d.id}
+}
+
+// collection holds the documents of one kind of entity. Ids are ObjectIDs, as in
+// MongoDB, so that both storages accept and hand out the same ids.
+type collection[T entity] struct {
+	resource string
+	// stamp sets the id, timestamps and version of a document on a copy of its entity.
+	stamp func(entity T, id, createdAt, updatedAt string, version int64)
+	// keepHistory archives the current revision of documents before they are updated.
+	keepHistory bool
+
+	mu   sync.RWMutex
+	docs map[primitive.ObjectID]*document[T]
+}
+
+func newCollection[T entity](resource string, stamp func(T, string, string, string, int64)) *collection[T] {
+	return &collection[T]{
+		resource: resource,
+		stamp:    stamp,
+		docs:     make(map[primitive.ObjectID]*document[T]),
+	}
+}
+
+// now returns the current time at the millisecond precision of BSON dates.
+func now() time.Time {
+	return time.Now().Truncate(time.Millisecond)
+}
+
+// parseID converts the id of an entity to an ObjectID.
+func (c *collection[T]) parseID(id string) (primitive.ObjectID, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return objID, errs.InvalidArgument("id", "invalid %s ID: %v", c.resource, err)
+	}
+	return objID, nil
+}
+
+// output returns a copy of the entity of a document, with its stored fields set.
+func (c *collection[T]) output(doc *document[T]) T {
+	out := proto.Clone(doc.entity).(T)
+	c.stamp(out, doc.id.Hex(), doc.createdAt.Format(time.RFC3339), doc.updatedAt.Format(time.RFC3339), doc.version)
+	return out
+}
+
+// create stores a new entity at version 1, under the given id if not empty.
+func (c *collection[T]) create(id string, entity T) (T, error) {
+	var zero T
+	objID := primitive.NewObjectID()
+	if id != "" {
+		var err error
+		if objID, err = c.parseID(id); err != nil {
+			return zero, err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.docs[objID]; ok {
+		return zero, errs.AlreadyExists(c.resource, objID.Hex())
+	}
+	createdAt := now()
+	doc := &document[T]{id: objID, entity: entity, createdAt: createdAt, updatedAt: createdAt, version: 1}
+	c.docs[objID] = doc
+	return c.output(doc), nil
+}
+
+// get returns an entity by id, excluding soft-deleted entities unless ctx includes them.
+func (c *collection[T]) get(ctx context.Context, id string) (T, error) {
+	var zero T
+	objID, err := c.parseID(id)
+	if err != nil {
+		return zero, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	doc, ok := c.docs[objID]
+	if !ok || (doc.deleted() && !storage.IncludesDeleted(ctx)) {
+		return zero, errs.NotFound(c.resource, objID.Hex())
+	}
+	return c.output(doc), nil
+}
+
+// update replaces the entity of a document that is not soft-deleted with the one
+// built from a copy of its current entity, and increments its version. When expected
+// is not 0, the update fails with FailedPrecondition unless the document is at that
+// version.
+func (c *collection[T]) update(id string, expected int64, build func(current T) (T, error)) (T, error) {
+	var zero T
+	objID, err := c.parseID(id)
+	if err != nil {
+		return zero, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	doc, ok := c.docs[objID]
+	if !ok || doc.deleted() {
+		return zero, errs.NotFound(c.resource, objID.Hex())
+	}
+	if expected != 0 && expected != doc.version {
+		return zero, errs.FailedPrecondition(c.resource+"/"+objID.Hex(), "%s %s is at version %d, not %d",
+			c.resource, objID.Hex(), doc.version, expected)
+	}
+
+	updated, err := build(proto.Clone(doc.entity).(T))
+	if err != nil {
+		return zero, err
+	}
+	if c.keepHistory {
+		doc.history = append(doc.history, c.output(doc))
+	}
+	doc.entity = updated
+	doc.updatedAt = now()
+	doc.version++
+	return c.output(doc), nil
+}
+
+// softDelete marks a document as deleted by the actor of ctx. Documents already
+// deleted are reported as not found.
+func (c *collection[T]) softDelete(ctx context.Context, id string) error {
+	objID, err := c.parseID(id)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	doc, ok := c.docs[objID]
+	if !ok || doc.deleted() {
+		return errs.NotFound(c.resource, objID.Hex())
+	}
+	doc.deletedAt = now()
+	doc.deletedBy = storage.ActorFromContext(ctx)
+	return nil
+}
+
+// restore clears the deletion markers of a soft-deleted document and returns its entity.
+func (c *collection[T]) restore(id string) (T, error) {
+	var zero T
+	objID, err := c.parseID(id)
+	if err != nil {
+		return zero, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	doc, ok := c.docs[objID]
+	if !ok {
+		return zero, errs.NotFound(c.resource, objID.Hex())
+	}
+	if !doc.deleted() {
+		return zero, errs.FailedPrecondition(c.resource+"/"+objID.Hex(), "%s %s is not deleted", c.resource, objID.Hex())
+	}
+	doc.deletedAt, doc.deletedBy = time.Time{}, ""
+	doc.updatedAt = now()
+	return c.output(doc), nil
+}
+
+// list returns a page of the entities that are not soft-deleted and match, the token
+// of the next page (empty on the last page) and the total number of matches.
+func (c *collection[T]) list(match func(T) bool, pageSize int32, token, orderBy string) ([]T, string, int64, error) {
+	page, err := newPageRequest(pageSize, token, orderBy)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var docs []*document[T]
+	for _, doc := range c.docs {
+		if !doc.deleted() && match(doc.entity) {
+			docs = append(docs, doc)
+		}
+	}
+	total := int64(len(docs))
+	docs, next, err := paginate(page, docs, (*document[T]).key)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	entities := make([]T, len(docs))
+	for i, doc := range docs {
+		entities[i] = c.output(doc)
+	}
+	return entities, next, total, nil
+}
+
+// created returns copies of the documents of a user created within [from, to) that
+// are not soft-deleted, oldest first.
+func (c *collection[T]) created(userID string, from, to time.Time) []*document[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var docs []*document[T]
+	for _, doc := range c.docs {
+		if !doc.deleted() && doc.entity.GetUserId() == userID && !doc.createdAt.Before(from) && doc.createdAt.Before(to) {
+			copied := *doc
+			docs = append(docs, &copied)
+		}
+	}
+	sort.Slice(docs, func(i, j int) bool {
+		return compareKeys(storage.OrderByCreatedAt, docs[i].key(), docs[j].key()) < 0
+	})
+	return docs
+}
+
+// purge permanently removes the documents soft-deleted before deletedBefore, along
+// with their prior revisions, and returns how many were removed.
+func (c *collection[T]) purge(deletedBefore time.Time) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var purged int64
+	for id, doc := range c.docs {
+		if doc.deleted() && doc.deletedAt.Before(deletedBefore) {
+			delete(c.docs, id)
+			purged++
+		}
+	}
+	return purged
+}
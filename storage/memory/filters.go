@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/storage"
+)
+
+// formatDate converts a calendar date to its stored form, empty when empty.
+func formatDate(field, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	date, err := storage.ParseDate(field, value)
+	if err != nil {
+		return "", err
+	}
+	return date.Format(storage.DateLayout), nil
+}
+
+// formatTimestamp converts a timestamp to its stored form, empty when empty.
+func formatTimestamp(field, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	ts, err := storage.ParseTimestamp(field, value)
+	if err != nil {
+		return "", err
+	}
+	return ts.Format(storage.TimestampLayout), nil
+}
+
+// timeRange matches the stored dates or timestamps within a range. Empty values
+// never match a range.
+type timeRange struct {
+	exact, from, to *time.Time
+}
+
+func (r timeRange) match(value string, parse func(field, value string) (time.Time, error)) bool {
+	if r.exact == nil && r.from == nil && r.to == nil {
+		return true
+	}
+	if value == "" {
+		return false
+	}
+	t, err := parse("", value)
+	if err != nil {
+		return false
+	}
+	return (r.exact == nil || t.Equal(*r.exact)) &&
+		(r.from == nil || !t.Before(*r.from)) &&
+		(r.to == nil || t.Before(*r.to))
+}
+
+// dateRange returns the inclusive calendar date range (and optional exact date) on field.
+func dateRange(field, exact, from, to string) (func(string) bool, error) {
+	var r timeRange
+	for _, bound := range []struct {
+		field, value string
+		dst          **time.Time
+	}{
+		{field, exact, &r.exact},
+		{field + "_from", from, &r.from},
+		{field + "_to", to, &r.to},
+	} {
+		if bound.value == "" {
+			continue
+		}
+		date, err := storage.ParseDate(bound.field, bound.value)
+		if err != nil {
+			return nil, err
+		}
+		*bound.dst = &date
+	}
+	if r.to != nil {
+		end := r.to.AddDate(0, 0, 1) // Include the whole end day
+		r.to = &end
+	}
+	return func(value string) bool { return r.match(value, storage.ParseDate) }, nil
+}
+
+// timestampRange returns the half-open [from, to) timestamp range (and optional
+// exact timestamp) on field.
+func timestampRange(field, exact, from, to string) (func(string) bool, error) {
+	var r timeRange
+	for _, bound := range []struct {
+		field, value string
+		dst          **time.Time
+	}{
+		{field, exact, &r.exact},
+		{field + "_from", from, &r.from},
+		{field + "_to", to, &r.to},
+	} {
+		if bound.value == "" {
+			continue
+		}
+		ts, err := storage.ParseTimestamp(bound.field, bound.value)
+		if err != nil {
+			return nil, err
+		}
+		*bound.dst = &ts
+	}
+	return func(value string) bool { return r.match(value, storage.ParseTimestamp) }, nil
+}
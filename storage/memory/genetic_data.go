@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// GeneticDataRepo implements the storage.GeneticDataRepoI interface in memory.
+type GeneticDataRepo struct {
+	data *collection[*health.GeneticData]
+}
+
+func newGeneticDataRepo() *GeneticDataRepo {
+	return &GeneticDataRepo{data: newCollection("genetic data", func(data *health.GeneticData, id, createdAt, updatedAt string, version int64) {
+		data.Id, data.CreatedAt, data.UpdatedAt, data.Version = id, createdAt, updatedAt, version
+	})}
+}
+
+// CreateGeneticData creates a new genetic data record.
+func (r *GeneticDataRepo) CreateGeneticData(ctx context.Context, data *health.GeneticData) (*health.GeneticData, error) {
+	analysisDate, err := formatDate("analysis_date", data.AnalysisDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.data.create(data.Id, &health.GeneticData{
+		UserId:       data.UserId,
+		DataType:     data.DataType,
+		DataValue:    cloneAny(data.DataValue),
+		AnalysisDate: analysisDate,
+	})
+}
+
+// GetGeneticData retrieves a genetic data record by its ID.
+func (r *GeneticDataRepo) GetGeneticData(ctx context.Context, id string) (*health.GeneticData, error) {
+	return r.data.get(ctx, id)
+}
+
+// UpdateGeneticData updates an existing genetic data record. With an update_mask,
+// only the masked fields are written.
+func (r *GeneticDataRepo) UpdateGeneticData(ctx context.Context, data *health.GeneticData) (*health.GeneticData, error) {
+	masked := func(field string) bool { return storage.MaskIncludes(data.UpdateMask, field) }
+
+	return r.data.update(data.Id, data.ExpectedVersion, func(current *health.GeneticData) (*health.GeneticData, error) {
+		if masked("user_id") {
+			current.UserId = data.UserId
+		}
+		if masked("data_type") {
+			current.DataType = data.DataType
+		}
+		if masked("data_value") {
+			current.DataValue = cloneAny(data.DataValue)
+		}
+		if masked("analysis_date") {
+			analysisDate, err := formatDate("analysis_date", data.AnalysisDate)
+			if err != nil {
+				return nil, err
+			}
+			current.AnalysisDate = analysisDate
+		}
+		return current, nil
+	})
+}
+
+// DeleteGeneticData soft-deletes a genetic data record.
+func (r *GeneticDataRepo) DeleteGeneticData(ctx context.Context, id string) error {
+	return r.data.softDelete(ctx, id)
+}
+
+// RestoreGeneticData restores a genetic data record that was soft-deleted and not purged yet.
+func (r *GeneticDataRepo) RestoreGeneticData(ctx context.Context, id string) (*health.GeneticData, error) {
+	return r.data.restore(id)
+}
+
+// ListGeneticData retrieves the genetic data records matching the filters of the request.
+func (r *GeneticDataRepo) ListGeneticData(ctx context.Context, req *health.ListGeneticDataRequest) (*health.ListGeneticDataResponse, error) {
+	if err := storage.RejectPayloadFilters(req.DataValueFilters); err != nil {
+		return nil, err
+	}
+	inRange, err := dateRange("analysis_date", req.AnalysisDate, req.AnalysisDateFrom, req.AnalysisDateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	data, nextPageToken, totalSize, err := r.data.list(func(data *health.GeneticData) bool {
+		return (req.UserId == "" || data.UserId == req.UserId) &&
+			(req.DataType == "" || data.DataType == req.DataType) &&
+			inRange(data.AnalysisDate)
+	}, req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &health.ListGeneticDataResponse{
+		GeneticData:   data,
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}, nil
+}
+
+// cloneAny copies a data_value payload, so that callers cannot modify stored data.
+func cloneAny(value *anypb.Any) *anypb.Any {
+	if value == nil {
+		return nil
+	}
+	return proto.Clone(value).(*anypb.Any)
+}
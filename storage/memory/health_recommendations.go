@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+)
+
+// HealthRecommendationRepo implements the storage.HealthRecommendationRepoI interface in memory.
+type HealthRecommendationRepo struct {
+	recommendations *collection[*health.HealthRecommendation]
+}
+
+func newHealthRecommendationRepo() *HealthRecommendationRepo {
+	return &HealthRecommendationRepo{recommendations: newCollection("health recommendation",
+		func(recommendation *health.HealthRecommendation, id, createdAt, updatedAt string, version int64) {
+			recommendation.Id, recommendation.CreatedAt, recommendation.UpdatedAt, recommendation.Version = id, createdAt, updatedAt, version
+		})}
+}
+
+// CreateHealthRecommendation creates a new health recommendation.
+func (r *HealthRecommendationRepo) CreateHealthRecommendation(ctx context.Context, recommendation *health.HealthRecommendation) (*health.HealthRecommendation, error) {
+	return r.recommendations.create(recommendation.Id, &health.HealthRecommendation{
+		UserId:             recommendation.UserId,
+		RecommendationType: recommendation.RecommendationType,
+		Description:        recommendation.Description,
+		Priority:           recommendation.Priority,
+	})
+}
+
+// GetHealthRecommendation retrieves a health recommendation by its ID.
+func (r *HealthRecommendationRepo) GetHealthRecommendation(ctx context.Context, id string) (*health.HealthRecommendation, error) {
+	return r.recommendations.get(ctx, id)
+}
+
+// UpdateHealthRecommendation updates an existing health recommendation. With an
+// update_mask, only the masked fields are written.
+func (r *HealthRecommendationRepo) UpdateHealthRecommendation(ctx context.Context, recommendation *health.HealthRecommendation) (*health.HealthRecommendation, error) {
+	masked := func(field string) bool { return storage.MaskIncludes(recommendation.UpdateMask, field) }
+
+	return r.recommendations.update(recommendation.Id, recommendation.ExpectedVersion, func(current *health.HealthRecommendation) (*health.HealthRecommendation, error) {
+		if masked("user_id") {
+			current.UserId = recommendation.UserId
+		}
+		if masked("recommendation_type") {
+			current.RecommendationType = recommendation.RecommendationType
+		}
+		if masked("description") {
+			current.Description = recommendation.Description
+		}
+		if masked("priority") {
+			current.Priority = recommendation.Priority
+		}
+		return current, nil
+	})
+}
+
+// DeleteHealthRecommendation soft-deletes a health recommendation.
+func (r *HealthRecommendationRepo) DeleteHealthRecommendation(ctx context.Context, id string) error {
+	return r.recommendations.softDelete(ctx, id)
+}
+
+// RestoreHealthRecommendation restores a health recommendation that was soft-deleted and not purged yet.
+func (r *HealthRecommendationRepo) RestoreHealthRecommendation(ctx context.Context, id string) (*health.HealthRecommendation, error) {
+	return r.recommendations.restore(id)
+}
+
+// ListHealthRecommendations retrieves the health recommendations matching the filters of the request.
+func (r *HealthRecommendationRepo) ListHealthRecommendations(ctx context.Context, req *health.ListHealthRecommendationsRequest) (*health.ListHealthRecommendationsResponse, error) {
+	recommendations, nextPageToken, totalSize, err := r.recommendations.list(func(recommendation *health.HealthRecommendation) bool {
+		return (req.UserId == "" || recommendation.UserId == req.UserId) &&
+			(req.RecommendationType == "" || recommendation.RecommendationType == req.RecommendationType) &&
+			(req.Priority == 0 || recommendation.Priority == req.Priority)
+	}, req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &health.ListHealthRecommendationsResponse{
+		HealthRecommendations: recommendations,
+		NextPageToken:         nextPageToken,
+		TotalSize:             totalSize,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+)
+
+// LifestyleDataRepo implements the storage.LifestyleDataRepoI interface in memory.
+type LifestyleDataRepo struct {
+	data *collection[*health.LifestyleData]
+}
+
+func newLifestyleDataRepo() *LifestyleDataRepo {
+	return &LifestyleDataRepo{data: newCollection("lifestyle data", func(data *health.LifestyleData, id, createdAt, updatedAt string, version int64) {
+		data.Id, data.CreatedAt, data.UpdatedAt, data.Version = id, createdAt, updatedAt, version
+	})}
+}
+
+// CreateLifestyleData creates a new lifestyle data record.
+func (r *LifestyleDataRepo) CreateLifestyleData(ctx context.Context, data *health.LifestyleData) (*health.LifestyleData, error) {
+	recordedDate, err := formatDate("recorded_date", data.RecordedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.data.create(data.Id, &health.LifestyleData{
+		UserId:       data.UserId,
+		DataType:     data.DataType,
+		DataValue:    cloneAny(data.DataValue),
+		RecordedDate: recordedDate,
+	})
+}
+
+// GetLifestyleData retrieves a lifestyle data record by its ID.
+func (r *LifestyleDataRepo) GetLifestyleData(ctx context.Context, id string) (*health.LifestyleData, error) {
+	return r.data.get(ctx, id)
+}
+
+// UpdateLifestyleData updates an existing lifestyle data record. With an update_mask,
+// only the masked fields are written.
+func (r *LifestyleDataRepo) UpdateLifestyleData(ctx context.Context, data *health.LifestyleData) (*health.LifestyleData, error) {
+	masked := func(field string) bool { return storage.MaskIncludes(data.UpdateMask, field) }
+
+	return r.data.update(data.Id, data.ExpectedVersion, func(current *health.LifestyleData) (*health.LifestyleData, error) {
+		if masked("user_id") {
+			current.UserId = data.UserId
+		}
+		if masked("data_type") {
+			current.DataType = data.DataType
+		}
+		if masked("data_value") {
+			current.DataValue = cloneAny(data.DataValue)
+		}
+		if masked("recorded_date") {
+			recordedDate, err := formatDate("recorded_date", data.RecordedDate)
+			if err != nil {
+				return nil, err
+			}
+			current.RecordedDate = recordedDate
+		}
+		return current, nil
+	})
+}
+
+// DeleteLifestyleData soft-deletes a lifestyle data record.
+func (r *LifestyleDataRepo) DeleteLifestyleData(ctx context.Context, id string) error {
+	return r.data.softDelete(ctx, id)
+}
+
+// RestoreLifestyleData restores a lifestyle data record that was soft-deleted and not purged yet.
+func (r *LifestyleDataRepo) RestoreLifestyleData(ctx context.Context, id string) (*health.LifestyleData, error) {
+	return r.data.restore(id)
+}
+
+// ListLifestyleData retrieves the lifestyle data records matching the filters of the request.
+func (r *LifestyleDataRepo) ListLifestyleData(ctx context.Context, req *health.ListLifestyleDataRequest) (*health.ListLifestyleDataResponse, error) {
+	inRange, err := dateRange("recorded_date", req.RecordedDate, req.RecordedDateFrom, req.RecordedDateTo)
+	if err != nil {
+		return nil, err
+	}
+	payloadFilters, err := storage.ParsePayloadFilters(req.DataValueFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	data, nextPageToken, totalSize, err := r.data.list(func(data *health.LifestyleData) bool {
+		return (req.UserId == "" || data.UserId == req.UserId) &&
+			(req.DataType == "" || data.DataType == req.DataType) &&
+			inRange(data.RecordedDate) &&
+			storage.MatchPayload(data.DataValue, payloadFilters)
+	}, req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &health.ListLifestyleDataResponse{
+		LifestyleData: data,
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}, nil
+}
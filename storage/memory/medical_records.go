@@ -0,0 +1,199 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+// MedicalRecordRepo implements the storage.MedicalRecordRepoI interface in memory.
+// Prior revisions of the records are kept along with them.
+type MedicalRecordRepo struct {
+	records *collection[*health.MedicalRecord]
+}
+
+func newMedicalRecordRepo() *MedicalRecordRepo {
+	records := newCollection("medical record", func(record *health.MedicalRecord, id, createdAt, updatedAt string, version int64) {
+		record.Id, record.CreatedAt, record.UpdatedAt, record.Version = id, createdAt, updatedAt, version
+	})
+	records.keepHistory = true
+	return &MedicalRecordRepo{records: records}
+}
+
+// CreateMedicalRecord creates a new medical record.
+func (r *MedicalRecordRepo) CreateMedicalRecord(ctx context.Context, record *health.MedicalRecord) (*health.MedicalRecord, error) {
+	recordDate, err := formatDate("record_date", record.RecordDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.records.create(record.Id, &health.MedicalRecord{
+		UserId:      record.UserId,
+		RecordType:  record.RecordType,
+		RecordDate:  recordDate,
+		Description: record.Description,
+		DoctorId:    record.DoctorId,
+		Attachments: append([]string(nil), record.Attachments...),
+	})
+}
+
+// GetMedicalRecord retrieves a medical record by its ID.
+func (r *MedicalRecordRepo) GetMedicalRecord(ctx context.Context, id string) (*health.MedicalRecord, error) {
+	return r.records.get(ctx, id)
+}
+
+// UpdateMedicalRecord updates an existing medical record and archives its previous
+// revision. With an update_mask, only the masked fields are written, empty or not;
+// otherwise only non-empty fields are.
+func (r *MedicalRecordRepo) UpdateMedicalRecord(ctx context.Context, record *health.MedicalRecord) (*health.MedicalRecord, error) {
+	// Without an update_mask, the fields that are set are written
+	written := func(field string, set bool) bool {
+		if len(record.UpdateMask.GetPaths()) == 0 {
+			return set
+		}
+		return storage.MaskIncludes(record.UpdateMask, field)
+	}
+
+	return r.records.update(record.Id, record.ExpectedVersion, func(current *health.MedicalRecord) (*health.MedicalRecord, error) {
+		if written("user_id", record.UserId != "") {
+			current.UserId = record.UserId
+		}
+		if written("record_type", record.RecordType != "") {
+			current.RecordType = record.RecordType
+		}
+		if written("record_date", record.RecordDate != "") {
+			recordDate, err := formatDate("record_date", record.RecordDate)
+			if err != nil {
+				return nil, err
+			}
+			current.RecordDate = recordDate
+		}
+		if written("doctor_id", record.DoctorId != "") {
+			current.DoctorId = record.DoctorId
+		}
+		if written("description", record.Description != "") {
+			current.Description = record.Description
+		}
+		if written("attachments", len(record.Attachments) > 0) {
+			current.Attachments = append([]string(nil), record.Attachments...)
+		}
+		return current, nil
+	})
+}
+
+// DeleteMedicalRecord soft-deletes a medical record.
+func (r *MedicalRecordRepo) DeleteMedicalRecord(ctx context.Context, id string) error {
+	return r.records.softDelete(ctx, id)
+}
+
+// RestoreMedicalRecord restores a medical record that was soft-deleted and not purged yet.
+func (r *MedicalRecordRepo) RestoreMedicalRecord(ctx context.Context, id string) (*health.MedicalRecord, error) {
+	return r.records.restore(id)
+}
+
+// ListMedicalRecords retrieves the medical records matching the filters of the request.
+func (r *MedicalRecordRepo) ListMedicalRecords(ctx context.Context, req *health.ListMedicalRecordsRequest) (*health.ListMedicalRecordsResponse, error) {
+	inRange, err := dateRange("record_date", req.RecordDate, req.RecordDateFrom, req.RecordDateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	records, nextPageToken, totalSize, err := r.records.list(func(record *health.MedicalRecord) bool {
+		return (req.UserId == "" || record.UserId == req.UserId) &&
+			(req.RecordType == "" || record.RecordType == req.RecordType) &&
+			(req.Description == "" || record.Description == req.Description) &&
+			(req.DoctorId == "" || record.DoctorId == req.DoctorId) &&
+			inRange(record.RecordDate)
+	}, req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &health.ListMedicalRecordsResponse{
+		MedicalRecords: records,
+		NextPageToken:  nextPageToken,
+		TotalSize:      totalSize,
+	}, nil
+}
+
+// ListMedicalRecordVersions retrieves the prior revisions of a medical record, newest first.
+func (r *MedicalRecordRepo) ListMedicalRecordVersions(ctx context.Context, req *health.ListMedicalRecordVersionsRequest) (*health.ListMedicalRecordVersionsResponse, error) {
+	history, err := r.history(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	size, err := storage.PageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	before := int64(len(history) + 1)
+	if req.PageToken != "" {
+		if before, err = storage.ParseVersionPageToken(req.PageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	response := &health.ListMedicalRecordVersionsResponse{TotalSize: int64(len(history))}
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Version >= before {
+			continue
+		}
+		if int64(len(response.Versions)) == size {
+			response.NextPageToken = storage.VersionPageToken(response.Versions[len(response.Versions)-1].Version)
+			break
+		}
+		response.Versions = append(response.Versions, history[i])
+	}
+	return response, nil
+}
+
+// GetMedicalRecordVersion retrieves a revision of a medical record, either the
+// current one or a prior one.
+func (r *MedicalRecordRepo) GetMedicalRecordVersion(ctx context.Context, id string, version int64) (*health.MedicalRecord, error) {
+	if version < 1 {
+		return nil, errs.InvalidArgument("version", "version must be positive")
+	}
+	current, err := r.GetMedicalRecord(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if version == current.Version {
+		return current, nil
+	}
+
+	history, err := r.history(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, revision := range history {
+		if revision.Version == version {
+			return revision, nil
+		}
+	}
+	return nil, errs.NotFound("medical record version", fmt.Sprintf("%s@%d", id, version))
+}
+
+// history returns copies of the prior revisions of a medical record, oldest first.
+// The history of deleted records is hidden along with them.
+func (r *MedicalRecordRepo) history(ctx context.Context, id string) ([]*health.MedicalRecord, error) {
+	if _, err := r.GetMedicalRecord(ctx, id); err != nil {
+		return nil, err
+	}
+	objID, _ := r.records.parseID(id)
+
+	r.records.mu.RLock()
+	defer r.records.mu.RUnlock()
+	doc, ok := r.records.docs[objID]
+	if !ok {
+		return nil, errs.NotFound(r.records.resource, id)
+	}
+	history := make([]*health.MedicalRecord, len(doc.history))
+	for i, revision := range doc.history {
+		history[i] = proto.Clone(revision).(*health.MedicalRecord)
+	}
+	return history, nil
+}
@@ -0,0 +1,76 @@
+// Package memory implements storage.StorageI in memory, for tests and local
+// development. It follows the semantics of the MongoDB storage (ids, filters,
+// pagination, versions, soft deletion and summaries) but keeps no data across
+// restarts and stores sensitive fields unencrypted.
+package memory
+
+import (
+	"github.com/health-analytics-service/health-analytics-service/storage"
+)
+
+// Storage implements the storage.StorageI interface in memory.
+type Storage struct {
+	medicalRecordRepo        *MedicalRecordRepo
+	geneticDataRepo          *GeneticDataRepo
+	lifestyleDataRepo        *LifestyleDataRepo
+	wearableDataRepo         *WearableDataRepo
+	healthRecommendationRepo *HealthRecommendationRepo
+	healthMonitoringRepo     *HealthMonitoringRepo
+	auditLogRepo             *AuditLogRepo
+	retentionRepo            *RetentionRepo
+}
+
+// NewStorage creates a new, empty in-memory storage.
+func NewStorage() storage.StorageI {
+	s := &Storage{
+		medicalRecordRepo:        newMedicalRecordRepo(),
+		geneticDataRepo:          newGeneticDataRepo(),
+		lifestyleDataRepo:        newLifestyleDataRepo(),
+		wearableDataRepo:         newWearableDataRepo(),
+		healthRecommendationRepo: newHealthRecommendationRepo(),
+		auditLogRepo:             &AuditLogRepo{},
+	}
+	s.healthMonitoringRepo = &HealthMonitoringRepo{storage: s}
+	s.retentionRepo = &RetentionRepo{storage: s}
+	return s
+}
+
+// MedicalRecord returns the in-memory MedicalRecordRepoI implementation.
+func (s *Storage) MedicalRecord() storage.MedicalRecordRepoI {
+	return s.medicalRecordRepo
+}
+
+// GeneticData returns the in-memory GeneticDataRepoI implementation.
+func (s *Storage) GeneticData() storage.GeneticDataRepoI {
+	return s.geneticDataRepo
+}
+
+// LifestyleData returns the in-memory LifestyleDataRepoI implementation.
+func (s *Storage) LifestyleData() storage.LifestyleDataRepoI {
+	return s.lifestyleDataRepo
+}
+
+// WearableData returns the in-memory WearableDataRepoI implementation.
+func (s *Storage) WearableData() storage.WearableDataRepoI {
+	return s.wearableDataRepo
+}
+
+// HealthRecommendation returns the in-memory HealthRecommendationRepoI implementation.
+func (s *Storage) HealthRecommendation() storage.HealthRecommendationRepoI {
+	return s.healthRecommendationRepo
+}
+
+// HealthMonitoring returns the in-memory HealthMonitoringRepoI implementation.
+func (s *Storage) HealthMonitoring() storage.HealthMonitoringRepoI {
+	return s.healthMonitoringRepo
+}
+
+// AuditLog returns the in-memory AuditLogRepoI implementation.
+func (s *Storage) AuditLog() storage.AuditLogRepoI {
+	return s.auditLogRepo
+}
+
+// Retention returns the in-memory RetentionRepoI implementation.
+func (s *Storage) Retention() storage.RetentionRepoI {
+	return s.retentionRepo
+}
@@ -0,0 +1,176 @@
+package memory
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+)
+
+// HealthMonitoringRepo implements the storage.HealthMonitoringRepoI interface in memory.
+type HealthMonitoringRepo struct {
+	storage *Storage
+}
+
+// GetDailySummary retrieves a daily summary of health data for a given user ID and date.
+func (r *HealthMonitoringRepo) GetDailySummary(ctx context.Context, req *health.DailySummaryRequest) (*health.SummaryResponse, error) {
+	window, err := storage.DailySummaryWindow(req)
+	if err != nil {
+		return nil, err
+	}
+	return r.getSummary(req.UserId, window), nil
+}
+
+// GetWeeklySummary retrieves a weekly summary of health data for a given user ID and date range.
+func (r *HealthMonitoringRepo) GetWeeklySummary(ctx context.Context, req *health.WeeklySummaryRequest) (*health.SummaryResponse, error) {
+	window, err := storage.WeeklySummaryWindow(req)
+	if err != nil {
+		return nil, err
+	}
+	return r.getSummary(req.UserId, window), nil
+}
+
+// getSummary computes the aggregates of all health data created by the user within
+// the window, bucketing days in its location. Raw records are only included when
+// the window includes them, oldest first and up to its limit.
+func (r *HealthMonitoringRepo) getSummary(userID string, window storage.SummaryWindow) *health.SummaryResponse {
+	summaryResponse := &health.SummaryResponse{}
+
+	// Pre-populate one bucket per calendar day so that days without data are reported too
+	buckets := make(map[string]*health.DailyBucket)
+	for _, day := range window.Days() {
+		bucket := &health.DailyBucket{Date: day}
+		buckets[bucket.Date] = bucket
+		summaryResponse.DailyBuckets = append(summaryResponse.DailyBuckets, bucket)
+	}
+	s := &summarizer{userID: userID, window: window, response: summaryResponse, buckets: buckets}
+
+	medicalRecords := summarize(s, r.storage.medicalRecordRepo.records, "medical_records",
+		(*health.MedicalRecord).GetRecordType, func(b *health.DailyBucket) *int64 { return &b.MedicalRecords })
+	geneticData := summarize(s, r.storage.geneticDataRepo.data, "genetic_data",
+		(*health.GeneticData).GetDataType, func(b *health.DailyBucket) *int64 { return &b.GeneticData })
+	lifestyleData := summarize(s, r.storage.lifestyleDataRepo.data, "lifestyle_data",
+		(*health.LifestyleData).GetDataType, func(b *health.DailyBucket) *int64 { return &b.LifestyleData })
+	wearableData := summarize(s, r.storage.wearableDataRepo.data, "wearable_data",
+		(*health.WearableData).GetDataType, func(b *health.DailyBucket) *int64 { return &b.WearableData })
+	healthRecommendations := summarize(s, r.storage.healthRecommendationRepo.recommendations, "health_recommendations",
+		(*health.HealthRecommendation).GetRecommendationType, func(b *health.DailyBucket) *int64 { return &b.HealthRecommendations })
+
+	if window.Records > 0 {
+		truncated := &summaryResponse.RecordsTruncated
+		summaryResponse.MedicalRecords = outputs(r.storage.medicalRecordRepo.records, storage.LimitSummaryRecords(medicalRecords, window, truncated))
+		summaryResponse.GeneticData = outputs(r.storage.geneticDataRepo.data, storage.LimitSummaryRecords(geneticData, window, truncated))
+		summaryResponse.LifestyleData = outputs(r.storage.lifestyleDataRepo.data, storage.LimitSummaryRecords(lifestyleData, window, truncated))
+		summaryResponse.WearableData = outputs(r.storage.wearableDataRepo.data, storage.LimitSummaryRecords(wearableData, window, truncated))
+		summaryResponse.HealthRecommendations = outputs(r.storage.healthRecommendationRepo.recommendations,
+			storage.LimitSummaryRecords(healthRecommendations, window, truncated))
+	}
+
+	// Compute wearable metrics over the whole window and per day
+	values := make(map[string][]float64)
+	dailyValues := make(map[string]map[string][]float64)
+	for _, doc := range wearableData {
+		value, ok := wearableValue(doc.entity)
+		if !ok {
+			continue
+		}
+		dataType := doc.entity.DataType
+		values[dataType] = append(values[dataType], value)
+		day := doc.createdAt.In(window.Location).Format(storage.DateLayout)
+		if dailyValues[day] == nil {
+			dailyValues[day] = make(map[string][]float64)
+		}
+		dailyValues[day][dataType] = append(dailyValues[day][dataType], value)
+	}
+	summaryResponse.WearableMetrics = wearableMetrics(values)
+	for day, values := range dailyValues {
+		if bucket, ok := buckets[day]; ok {
+			bucket.WearableMetrics = wearableMetrics(values)
+		}
+	}
+
+	return summaryResponse
+}
+
+// summarizer accumulates the type counts and daily counts of a summary.
+type summarizer struct {
+	userID   string
+	window   storage.SummaryWindow
+	response *health.SummaryResponse
+	buckets  map[string]*health.DailyBucket
+}
+
+// summarize adds the type counts (grouped by typeOf) and daily counts of the
+// documents of the user in a collection, and returns those documents.
+func summarize[T entity](s *summarizer, c *collection[T], name string, typeOf func(T) string, count func(*health.DailyBucket) *int64) []*document[T] {
+	docs := c.created(s.userID, s.window.From, s.window.To)
+
+	typeCounts := make(map[string]int64)
+	for _, doc := range docs {
+		typeCounts[typeOf(doc.entity)]++
+		if bucket, ok := s.buckets[doc.createdAt.In(s.window.Location).Format(storage.DateLayout)]; ok {
+			*count(bucket)++
+		}
+	}
+	types := make([]string, 0, len(typeCounts))
+	for t := range typeCounts {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	for _, t := range types {
+		s.response.TypeCounts = append(s.response.TypeCounts, &health.TypeCount{Collection: name, Type: t, Count: typeCounts[t]})
+	}
+
+	return docs
+}
+
+// outputs returns the entities of documents.
+func outputs[T entity](c *collection[T], docs []*document[T]) []T {
+	var entities []T
+	for _, doc := range docs {
+		entities = append(entities, c.output(doc))
+	}
+	return entities
+}
+
+// wearableMetrics computes the statistics of the values of each wearable data type,
+// sorted by data type.
+func wearableMetrics(values map[string][]float64) []*health.MetricStats {
+	dataTypes := make([]string, 0, len(values))
+	for dataType := range values {
+		dataTypes = append(dataTypes, dataType)
+	}
+	sort.Strings(dataTypes)
+
+	var metrics []*health.MetricStats
+	for _, dataType := range dataTypes {
+		metrics = append(metrics, metricStats(dataType, values[dataType]))
+	}
+	return metrics
+}
+
+// metricStats computes the statistics of the values of a wearable data type.
+func metricStats(dataType string, values []float64) *health.MetricStats {
+	sort.Float64s(values)
+	stats := &health.MetricStats{
+		DataType: dataType,
+		Count:    int64(len(values)),
+		Min:      values[0],
+		Max:      values[len(values)-1],
+	}
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+	stats.Mean = sum / float64(len(values))
+	stats.P50, stats.P90, stats.P99 = percentile(values, 0.5), percentile(values, 0.9), percentile(values, 0.99)
+	return stats
+}
+
+// percentile returns the nearest-rank percentile p of sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(rank, 0)]
+}
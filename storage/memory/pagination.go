@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// pageRequest describes a single page of a keyset-paginated list.
+type pageRequest struct {
+	size    int64
+	orderBy string // storage.OrderByCreatedAt or storage.OrderByID
+	desc    bool
+	after   *sortKey
+}
+
+// pageToken is the opaque cursor handed out as next_page_token. It holds the sort
+// keys of the last entity of the previous page.
+type pageToken struct {
+	OrderBy   string `json:"o"`
+	CreatedAt int64  `json:"c,omitempty"` // Unix nanoseconds
+	ID        string `json:"i"`
+}
+
+// sortKey holds the keys entities are sorted by.
+type sortKey struct {
+	createdAt time.Time
+	id        primitive.ObjectID
+}
+
+// compareKeys compares two sort keys in ascending order of orderBy, tie-broken by id.
+func compareKeys(orderBy string, a, b sortKey) int {
+	if orderBy == storage.OrderByCreatedAt {
+		if c := a.createdAt.Compare(b.createdAt); c != 0 {
+			return c
+		}
+	}
+	return bytes.Compare(a.id[:], b.id[:])
+}
+
+// newPageRequest validates the pagination parameters of a list request.
+func newPageRequest(pageSize int32, token, orderBy string) (*pageRequest, error) {
+	size, err := storage.PageSize(pageSize)
+	if err != nil {
+		return nil, err
+	}
+	key, desc, err := storage.ParseOrderBy(orderBy)
+	if err != nil {
+		return nil, err
+	}
+	page := &pageRequest{size: size, orderBy: key, desc: desc}
+
+	if token != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			return nil, errs.InvalidArgument("page_token", "invalid page_token")
+		}
+		var after pageToken
+		if err := json.Unmarshal(raw, &after); err != nil {
+			return nil, errs.InvalidArgument("page_token", "invalid page_token")
+		}
+		if after.OrderBy != page.key() {
+			return nil, errs.InvalidArgument("page_token", "page_token does not match order_by")
+		}
+		id, err := primitive.ObjectIDFromHex(after.ID)
+		if err != nil {
+			return nil, errs.InvalidArgument("page_token", "invalid page_token")
+		}
+		page.after = &sortKey{createdAt: time.Unix(0, after.CreatedAt), id: id}
+	}
+
+	return page, nil
+}
+
+// key identifies the sort order so that tokens cannot be replayed against another order.
+func (p *pageRequest) key() string {
+	if p.desc {
+		return p.orderBy + " desc"
+	}
+	return p.orderBy + " asc"
+}
+
+// compare compares two sort keys in the order of the page.
+func (p *pageRequest) compare(a, b sortKey) int {
+	c := compareKeys(p.orderBy, a, b)
+	if p.desc {
+		return -c
+	}
+	return c
+}
+
+// nextToken builds the page token pointing after the given sort key.
+func (p *pageRequest) nextToken(key sortKey) (string, error) {
+	token := pageToken{OrderBy: p.key(), ID: key.id.Hex()}
+	if p.orderBy == storage.OrderByCreatedAt {
+		token.CreatedAt = key.createdAt.UnixNano()
+	}
+	raw, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// paginate sorts items in the order of the page and returns the items of the page
+// along with the token of the next page (empty on the last page).
+func paginate[E any](page *pageRequest, items []E, key func(E) sortKey) ([]E, string, error) {
+	sort.Slice(items, func(i, j int) bool {
+		return page.compare(key(items[i]), key(items[j])) < 0
+	})
+	if page.after != nil {
+		start := sort.Search(len(items), func(i int) bool {
+			return page.compare(key(items[i]), *page.after) > 0
+		})
+		items = items[start:]
+	}
+
+	if int64(len(items)) <= page.size {
+		return items, "", nil
+	}
+	items = items[:page.size]
+	next, err := page.nextToken(key(items[len(items)-1]))
+	if err != nil {
+		return nil, "", err
+	}
+	return items, next, nil
+}
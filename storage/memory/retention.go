@@ -0,0 +1,22 @@
+package memory
+
+import (
+	"context"
+	"time"
+)
+
+// RetentionRepo implements the storage.RetentionRepoI interface in memory.
+type RetentionRepo struct {
+	storage *Storage
+}
+
+// PurgeDeleted permanently removes the entities soft-deleted before deletedBefore,
+// along with their prior revisions.
+func (r *RetentionRepo) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	s := r.storage
+	return s.medicalRecordRepo.records.purge(deletedBefore) +
+		s.geneticDataRepo.data.purge(deletedBefore) +
+		s.lifestyleDataRepo.data.purge(deletedBefore) +
+		s.wearableDataRepo.data.purge(deletedBefore) +
+		s.healthRecommendationRepo.recommendations.purge(deletedBefore), nil
+}
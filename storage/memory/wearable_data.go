@@ -0,0 +1,129 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+)
+
+// WearableDataRepo implements the storage.WearableDataRepoI interface in memory.
+type WearableDataRepo struct {
+	data *collection[*health.WearableData]
+}
+
+func newWearableDataRepo() *WearableDataRepo {
+	return &WearableDataRepo{data: newCollection("wearable data", func(data *health.WearableData, id, createdAt, updatedAt string, version int64) {
+		data.Id, data.CreatedAt, data.UpdatedAt, data.Version = id, createdAt, updatedAt, version
+	})}
+}
+
+// CreateWearableData creates a new wearable data record. The payload of known data
+// types is validated against the data type.
+func (r *WearableDataRepo) CreateWearableData(ctx context.Context, data *health.WearableData) (*health.WearableData, error) {
+	if _, _, err := storage.UnpackWearablePayload(data.DataType, data.DataValue); err != nil {
+		return nil, err
+	}
+	recordedTimestamp, err := formatTimestamp("recorded_timestamp", data.RecordedTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.data.create(data.Id, &health.WearableData{
+		UserId:            data.UserId,
+		DeviceType:        data.DeviceType,
+		DataType:          data.DataType,
+		DataValue:         cloneAny(data.DataValue),
+		RecordedTimestamp: recordedTimestamp,
+	})
+}
+
+// GetWearableData retrieves a wearable data record by its ID.
+func (r *WearableDataRepo) GetWearableData(ctx context.Context, id string) (*health.WearableData, error) {
+	return r.data.get(ctx, id)
+}
+
+// UpdateWearableData updates an existing wearable data record. With an update_mask,
+// only the masked fields are written; data_type and data_value always go together.
+func (r *WearableDataRepo) UpdateWearableData(ctx context.Context, data *health.WearableData) (*health.WearableData, error) {
+	masked := func(field string) bool { return storage.MaskIncludes(data.UpdateMask, field) }
+
+	return r.data.update(data.Id, data.ExpectedVersion, func(current *health.WearableData) (*health.WearableData, error) {
+		if masked("user_id") {
+			current.UserId = data.UserId
+		}
+		if masked("device_type") {
+			current.DeviceType = data.DeviceType
+		}
+		if masked("data_type") || masked("data_value") {
+			if _, _, err := storage.UnpackWearablePayload(data.DataType, data.DataValue); err != nil {
+				return nil, err
+			}
+			current.DataType = data.DataType
+			current.DataValue = cloneAny(data.DataValue)
+		}
+		if masked("recorded_timestamp") {
+			recordedTimestamp, err := formatTimestamp("recorded_timestamp", data.RecordedTimestamp)
+			if err != nil {
+				return nil, err
+			}
+			current.RecordedTimestamp = recordedTimestamp
+		}
+		return current, nil
+	})
+}
+
+// DeleteWearableData soft-deletes a wearable data record.
+func (r *WearableDataRepo) DeleteWearableData(ctx context.Context, id string) error {
+	return r.data.softDelete(ctx, id)
+}
+
+// RestoreWearableData restores a wearable data record that was soft-deleted and not purged yet.
+func (r *WearableDataRepo) RestoreWearableData(ctx context.Context, id string) (*health.WearableData, error) {
+	return r.data.restore(id)
+}
+
+// ListWearableData retrieves the wearable data records matching the filters of the request.
+func (r *WearableDataRepo) ListWearableData(ctx context.Context, req *health.ListWearableDataRequest) (*health.ListWearableDataResponse, error) {
+	inRange, err := timestampRange("recorded_timestamp", req.RecordedTimestamp, req.RecordedTimestampFrom, req.RecordedTimestampTo)
+	if err != nil {
+		return nil, err
+	}
+	payloadFilters, err := storage.ParsePayloadFilters(req.DataValueFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	data, nextPageToken, totalSize, err := r.data.list(func(data *health.WearableData) bool {
+		return (req.UserId == "" || data.UserId == req.UserId) &&
+			(req.DeviceType == "" || data.DeviceType == req.DeviceType) &&
+			(req.DataType == "" || data.DataType == req.DataType) &&
+			inRange(data.RecordedTimestamp) &&
+			storage.MatchPayload(data.DataValue, payloadFilters)
+	}, req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &health.ListWearableDataResponse{
+		WearableData:  data,
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}, nil
+}
+
+// wearableValue returns the numeric reading of wearable data aggregated in summaries,
+// if its payload has one.
+func wearableValue(data *health.WearableData) (float64, bool) {
+	msg, _, err := storage.UnpackWearablePayload(data.DataType, data.DataValue)
+	if err != nil {
+		return 0, false
+	}
+	if msg == nil && data.DataValue != nil {
+		msg, _ = data.DataValue.UnmarshalNew()
+	}
+	if msg == nil {
+		return 0, false
+	}
+	return storage.WearableValue(msg)
+}
@@ -0,0 +1,141 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// AuditLogRepo implements the storage.AuditLogRepoI interface for MongoDB. The
+// audit_log collection is append-only: entries are inserted and queried, never
+// updated or deleted.
+type AuditLogRepo struct {
+	db *mongo.Database
+}
+
+// NewAuditLogRepo creates a new AuditLogRepo instance.
+func NewAuditLogRepo(db *mongo.Database) *AuditLogRepo {
+	return &AuditLogRepo{
+		db: db,
+	}
+}
+
+// AppendAuditEntry appends an entry to the audit log. The entry is stamped with the
+// current time unless it carries its own timestamp.
+func (r *AuditLogRepo) AppendAuditEntry(ctx context.Context, entry *health.AuditEntry) error {
+	timestamp := time.Now()
+	if entry.Timestamp != "" {
+		ts, err := storage.ParseTimestamp("timestamp", entry.Timestamp)
+		if err != nil {
+			return err
+		}
+		timestamp = ts
+	}
+
+	bsonEntry := bson.M{
+		"_id":           primitive.NewObjectID(),
+		"actor":         entry.Actor,
+		"actor_roles":   entry.ActorRoles,
+		"action":        entry.Action,
+		"resource_type": entry.ResourceType,
+		"resource_id":   entry.ResourceId,
+		"user_id":       entry.UserId,
+		"outcome":       entry.Outcome,
+		"source":        entry.Source,
+		"method":        entry.Method,
+		"error":         entry.Error,
+		"created_at":    timestamp,
+	}
+	if _, err := r.db.Collection("audit_log").InsertOne(ctx, bsonEntry); err != nil {
+		return errs.Wrap(err, "failed to append audit entry")
+	}
+	return nil
+}
+
+// QueryAuditLog returns a page of audit entries matching the filters, newest first.
+func (r *AuditLogRepo) QueryAuditLog(ctx context.Context, req *health.QueryAuditLogRequest) (*health.QueryAuditLogResponse, error) {
+	filter := bson.M{}
+	if req.UserId != "" {
+		filter["user_id"] = req.UserId
+	}
+	if req.Actor != "" {
+		filter["actor"] = req.Actor
+	}
+	if req.ResourceType != "" {
+		filter["resource_type"] = req.ResourceType
+	}
+	if req.ResourceId != "" {
+		filter["resource_id"] = req.ResourceId
+	}
+	if err := addTimestampRangeFilter(filter, "created_at", "", req.From, req.To); err != nil {
+		return nil, err
+	}
+
+	page, err := newPageRequest(req.PageSize, req.PageToken, "")
+	if err != nil {
+		return nil, err
+	}
+
+	docs, nextPageToken, totalSize, err := findPage(ctx, r.db.Collection("audit_log"), filter, page)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to query audit log")
+	}
+
+	response := &health.QueryAuditLogResponse{
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}
+	for _, doc := range docs {
+		entry, err := bsonToAuditEntry(doc)
+		if err != nil {
+			return nil, err
+		}
+		response.Entries = append(response.Entries, entry)
+	}
+
+	return response, nil
+}
+
+// bsonToAuditEntry converts a BSON document to a health.AuditEntry proto message.
+func bsonToAuditEntry(doc bson.M) (*health.AuditEntry, error) {
+	oid, ok := doc["_id"].(primitive.ObjectID)
+	if !ok {
+		return nil, fmt.Errorf("invalid _id type: %T", doc["_id"])
+	}
+	entry := &health.AuditEntry{
+		Id:        oid.Hex(),
+		Timestamp: formatTimestamp(doc["created_at"]),
+	}
+
+	for field, value := range map[string]*string{
+		"actor":         &entry.Actor,
+		"action":        &entry.Action,
+		"resource_type": &entry.ResourceType,
+		"resource_id":   &entry.ResourceId,
+		"user_id":       &entry.UserId,
+		"outcome":       &entry.Outcome,
+		"source":        &entry.Source,
+		"method":        &entry.Method,
+		"error":         &entry.Error,
+	} {
+		if val, ok := doc[field].(string); ok {
+			*value = val
+		}
+	}
+	if roles, ok := doc["actor_roles"].(primitive.A); ok {
+		for _, role := range roles {
+			if val, ok := role.(string); ok {
+				entry.ActorRoles = append(entry.ActorRoles, val)
+			}
+		}
+	}
+
+	return entry, nil
+}
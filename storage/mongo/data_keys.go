@@ -0,0 +1,130 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/encryption"
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// DataKeyStore implements the encryption.KeyStore interface for MongoDB. Data keys
+// are stored wrapped in the data_keys collection, one document per user and version.
+type DataKeyStore struct {
+	db *mongo.Database
+}
+
+// NewDataKeyStore creates a new DataKeyStore instance.
+func NewDataKeyStore(db *mongo.Database) *DataKeyStore {
+	return &DataKeyStore{
+		db: db,
+	}
+}
+
+// dataKeyDocument is the stored form of a data key.
+type dataKeyDocument struct {
+	ID         string    `bson:"_id"`
+	UserID     string    `bson:"user_id"`
+	Version    int       `bson:"version"`
+	KEKID      string    `bson:"kek_id"`
+	WrappedKey []byte    `bson:"wrapped_key"`
+	CreatedAt  time.Time `bson:"created_at"`
+}
+
+// dataKeyID makes (user_id, version) unique without needing an index.
+func dataKeyID(userID string, version int) string {
+	return fmt.Sprintf("%s:%d", userID, version)
+}
+
+func (d *dataKeyDocument) dataKey() *encryption.DataKey {
+	return &encryption.DataKey{
+		UserID:     d.UserID,
+		Version:    d.Version,
+		KEKID:      d.KEKID,
+		WrappedKey: d.WrappedKey,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+// LatestDataKey returns the data key of the user with the highest version, or nil.
+func (s *DataKeyStore) LatestDataKey(ctx context.Context, userID string) (*encryption.DataKey, error) {
+	var doc dataKeyDocument
+	err := s.db.Collection("data_keys").FindOne(ctx, bson.M{"user_id": userID},
+		options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}})).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, errs.Wrap(err, "failed to get data key")
+	}
+	return doc.dataKey(), nil
+}
+
+// GetDataKey returns a data key of the user by version.
+func (s *DataKeyStore) GetDataKey(ctx context.Context, userID string, version int) (*encryption.DataKey, error) {
+	var doc dataKeyDocument
+	err := s.db.Collection("data_keys").FindOne(ctx, bson.M{"_id": dataKeyID(userID, version)}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.NotFound("data key", dataKeyID(userID, version))
+		}
+		return nil, errs.Wrap(err, "failed to get data key")
+	}
+	return doc.dataKey(), nil
+}
+
+// InsertDataKey stores a new data key.
+func (s *DataKeyStore) InsertDataKey(ctx context.Context, key *encryption.DataKey) error {
+	_, err := s.db.Collection("data_keys").InsertOne(ctx, dataKeyDocument{
+		ID:         dataKeyID(key.UserID, key.Version),
+		UserID:     key.UserID,
+		Version:    key.Version,
+		KEKID:      key.KEKID,
+		WrappedKey: key.WrappedKey,
+		CreatedAt:  key.CreatedAt,
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errs.AlreadyExists("data key", dataKeyID(key.UserID, key.Version))
+		}
+		return errs.Wrap(err, "failed to insert data key")
+	}
+	return nil
+}
+
+// RewrapDataKey replaces the KEK id and wrapped key of a stored data key.
+func (s *DataKeyStore) RewrapDataKey(ctx context.Context, key *encryption.DataKey) error {
+	result, err := s.db.Collection("data_keys").UpdateOne(ctx, bson.M{"_id": dataKeyID(key.UserID, key.Version)},
+		bson.M{"$set": bson.M{"kek_id": key.KEKID, "wrapped_key": key.WrappedKey}})
+	if err != nil {
+		return errs.Wrap(err, "failed to rewrap data key")
+	}
+	if result.MatchedCount == 0 {
+		return errs.NotFound("data key", dataKeyID(key.UserID, key.Version))
+	}
+	return nil
+}
+
+// ForEachDataKey calls fn for every stored data key.
+func (s *DataKeyStore) ForEachDataKey(ctx context.Context, fn func(*encryption.DataKey) error) error {
+	cursor, err := s.db.Collection("data_keys").Find(ctx, bson.M{})
+	if err != nil {
+		return errs.Wrap(err, "failed to find data keys")
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc dataKeyDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return errs.Wrap(err, "failed to decode data key")
+		}
+		if err := fn(doc.dataKey()); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
@@ -0,0 +1,207 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// dateFields lists the date fields stored as BSON dates, per collection. Calendar
+// dates are stored as midnight UTC, timestamps as the instant they denote.
+var dateFields = []struct {
+	collection string
+	field      string
+	timestamp  bool
+}{
+	{collection: "medical_records", field: "record_date"},
+	{collection: "genetic_data", field: "analysis_date"},
+	{collection: "lifestyle_data", field: "recorded_date"},
+	{collection: "wearable_data", field: "recorded_timestamp", timestamp: true},
+}
+
+// dateValue converts a calendar date to its stored form, nil when empty.
+func dateValue(field, value string) (interface{}, error) {
+	if value == "" {
+		return nil, nil
+	}
+	return storage.ParseDate(field, value)
+}
+
+// timestampValue converts a timestamp to its stored form, nil when empty.
+func timestampValue(field, value string) (interface{}, error) {
+	if value == "" {
+		return nil, nil
+	}
+	return storage.ParseTimestamp(field, value)
+}
+
+// formatDate renders a stored calendar date, accepting legacy string values.
+func formatDate(value interface{}) string {
+	switch v := value.(type) {
+	case primitive.DateTime:
+		return v.Time().UTC().Format(storage.DateLayout)
+	case string:
+		return v
+	default:
+		return ""
+	}
+}
+
+// formatTimestamp renders a stored timestamp, accepting legacy string values.
+func formatTimestamp(value interface{}) string {
+	switch v := value.(type) {
+	case primitive.DateTime:
+		return v.Time().UTC().Format(storage.TimestampLayout)
+	case string:
+		return v
+	default:
+		return ""
+	}
+}
+
+// addDateRangeFilter adds an inclusive calendar date range (and optional exact date)
+// on field to the filter.
+func addDateRangeFilter(filter bson.M, field, exact, from, to string) error {
+	cond := bson.M{}
+	if exact != "" {
+		date, err := storage.ParseDate(field, exact)
+		if err != nil {
+			return err
+		}
+		cond["$eq"] = date
+	}
+	if from != "" {
+		date, err := storage.ParseDate(field+"_from", from)
+		if err != nil {
+			return err
+		}
+		cond["$gte"] = date
+	}
+	if to != "" {
+		date, err := storage.ParseDate(field+"_to", to)
+		if err != nil {
+			return err
+		}
+		cond["$lt"] = date.AddDate(0, 0, 1) // Include the whole end day
+	}
+	if len(cond) > 0 {
+		filter[field] = cond
+	}
+	return nil
+}
+
+// addTimestampRangeFilter adds a half-open [from, to) timestamp range (and optional
+// exact timestamp) on field to the filter.
+func addTimestampRangeFilter(filter bson.M, field, exact, from, to string) error {
+	cond := bson.M{}
+	if exact != "" {
+		ts, err := storage.ParseTimestamp(field, exact)
+		if err != nil {
+			return err
+		}
+		cond["$eq"] = ts
+	}
+	if from != "" {
+		ts, err := storage.ParseTimestamp(field+"_from", from)
+		if err != nil {
+			return err
+		}
+		cond["$gte"] = ts
+	}
+	if to != "" {
+		ts, err := storage.ParseTimestamp(field+"_to", to)
+		if err != nil {
+			return err
+		}
+		cond["$lt"] = ts
+	}
+	if len(cond) > 0 {
+		filter[field] = cond
+	}
+	return nil
+}
+
+// migrateStringDates converts date fields still stored as strings by earlier
+// versions into BSON dates. Values that cannot be parsed are left untouched and
+// the migration is a no-op once all documents are converted.
+func migrateStringDates(ctx context.Context, db *mongo.Database) error {
+	for _, df := range dateFields {
+		collection := db.Collection(df.collection)
+
+		// Empty strings carried no date at all
+		if _, err := collection.UpdateMany(ctx,
+			bson.M{df.field: ""},
+			bson.M{"$set": bson.M{df.field: nil}},
+		); err != nil {
+			return errs.Wrap(err, "failed to clear empty %s.%s", df.collection, df.field)
+		}
+
+		result, err := collection.UpdateMany(ctx,
+			bson.M{df.field: bson.M{"$type": "string"}},
+			mongo.Pipeline{{{Key: "$set", Value: bson.M{df.field: stringToDate("$"+df.field, df.timestamp)}}}},
+		)
+		if err != nil {
+			return errs.Wrap(err, "failed to convert %s.%s to dates", df.collection, df.field)
+		}
+		if result.ModifiedCount > 0 {
+			slog.Info(fmt.Sprintf("converted %d %s.%s values to dates", result.ModifiedCount, df.collection, df.field))
+		}
+	}
+	return nil
+}
+
+// revertStringDates converts the date fields back into the strings stored by earlier
+// versions, undoing migrateStringDates.
+func revertStringDates(ctx context.Context, db *mongo.Database) error {
+	for _, df := range dateFields {
+		_, err := db.Collection(df.collection).UpdateMany(ctx,
+			bson.M{df.field: bson.M{"$type": "date"}},
+			mongo.Pipeline{{{Key: "$set", Value: bson.M{df.field: dateToString("$"+df.field, df.timestamp)}}}},
+		)
+		if err != nil {
+			return errs.Wrap(err, "failed to convert %s.%s to strings", df.collection, df.field)
+		}
+	}
+	return nil
+}
+
+// stringToDate returns the expression parsing a legacy string value the way
+// storage.ParseTimestamp and storage.ParseDate do, so that migrated documents match
+// newly written ones: timestamps keep their instant, while calendar dates keep the
+// day written in the string, whatever its offset. Values that cannot be parsed are
+// returned unchanged.
+func stringToDate(value string, timestamp bool) bson.M {
+	parsed := bson.M{"$dateFromString": bson.M{"dateString": value, "onError": value}}
+	if timestamp {
+		return parsed
+	}
+	return bson.M{"$cond": bson.M{
+		"if": bson.M{"$eq": bson.A{bson.M{"$type": parsed}, "date"}},
+		"then": bson.M{"$dateFromString": bson.M{
+			"dateString": bson.M{"$substrCP": bson.A{value, 0, 10}},
+			"format":     "%Y-%m-%d",
+			"timezone":   "UTC",
+			"onError":    value,
+		}},
+		"else": value,
+	}}
+}
+
+// dateToString returns the expression rendering a date in the string form of
+// storage.DateLayout or storage.TimestampLayout.
+func dateToString(value string, timestamp bool) bson.M {
+	if !timestamp {
+		return bson.M{"$dateToString": bson.M{"date": value, "format": "%Y-%m-%d"}}
+	}
+	return bson.M{"$cond": bson.M{
+		"if":   bson.M{"$eq": bson.A{bson.M{"$millisecond": value}, 0}},
+		"then": bson.M{"$dateToString": bson.M{"date": value, "format": "%Y-%m-%dT%H:%M:%SZ"}},
+		"else": bson.M{"$dateToString": bson.M{"date": value, "format": "%Y-%m-%dT%H:%M:%S.%LZ"}},
+	}}
+}
@@ -0,0 +1,92 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/health-analytics-service/health-analytics-service/encryption"
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Encrypted fields. Medical record descriptions also get a blind index, so that
+// they can still be filtered on by exact value.
+const (
+	descriptionField      = "description"
+	descriptionIndexField = "description_bidx"
+	attachmentsField      = "attachments"
+	dataValueField        = "data_value"
+)
+
+// encryptMedicalRecordFields sets the encrypted description (with its blind index)
+// and attachments of a medical record owned by userID on doc.
+func encryptMedicalRecordFields(ctx context.Context, cipher *encryption.Cipher, userID, description string, attachments []string, doc bson.M) error {
+	encrypted, err := cipher.Encrypt(ctx, userID, descriptionField, description)
+	if err != nil {
+		return errs.Wrap(err, "failed to encrypt description")
+	}
+	doc[descriptionField] = encrypted
+	doc[descriptionIndexField] = cipher.BlindIndex(descriptionField, description)
+
+	return encryptAttachments(ctx, cipher, userID, attachments, doc)
+}
+
+// encryptAttachments sets the encrypted attachments of a medical record on doc.
+func encryptAttachments(ctx context.Context, cipher *encryption.Cipher, userID string, attachments []string, doc bson.M) error {
+	encrypted := make([]string, len(attachments))
+	for i, attachment := range attachments {
+		var err error
+		if encrypted[i], err = cipher.Encrypt(ctx, userID, attachmentsField, attachment); err != nil {
+			return errs.Wrap(err, "failed to encrypt attachment")
+		}
+	}
+	doc[attachmentsField] = encrypted
+	return nil
+}
+
+// decryptMedicalRecordFields decrypts the description and attachments of a stored
+// medical record in place. Legacy plaintext values are left as they are.
+func decryptMedicalRecordFields(ctx context.Context, cipher *encryption.Cipher, doc bson.M) error {
+	if val, ok := doc[descriptionField].(string); ok {
+		plain, err := cipher.Decrypt(ctx, descriptionField, val)
+		if err != nil {
+			return errs.Wrap(err, "failed to decrypt description")
+		}
+		doc[descriptionField] = plain
+	}
+	if val, ok := doc[attachmentsField].(bson.A); ok {
+		plain := make(bson.A, len(val))
+		for i, attachment := range val {
+			plain[i] = attachment
+			if str, ok := attachment.(string); ok {
+				decrypted, err := cipher.Decrypt(ctx, attachmentsField, str)
+				if err != nil {
+					return errs.Wrap(err, "failed to decrypt attachment")
+				}
+				plain[i] = decrypted
+			}
+		}
+		doc[attachmentsField] = plain
+	}
+	return nil
+}
+
+// decryptGeneticDataFields decrypts the data_value of stored genetic data in place.
+func decryptGeneticDataFields(ctx context.Context, cipher *encryption.Cipher, doc bson.M) error {
+	if val, ok := doc[dataValueField].(string); ok {
+		plain, err := cipher.Decrypt(ctx, dataValueField, val)
+		if err != nil {
+			return errs.Wrap(err, "failed to decrypt data_value")
+		}
+		doc[dataValueField] = plain
+	}
+	return nil
+}
+
+// addDescriptionFilter matches medical records by exact description through the
+// blind index, or by plaintext for records not encrypted yet.
+func addDescriptionFilter(filter bson.M, cipher *encryption.Cipher, description string) {
+	filter["$or"] = bson.A{
+		bson.M{descriptionIndexField: cipher.BlindIndex(descriptionField, description)},
+		bson.M{descriptionField: description},
+	}
+}
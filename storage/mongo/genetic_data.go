@@ -5,30 +5,34 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/health-analytics-service/health-analytics-service/encryption"
+	"github.com/health-analytics-service/health-analytics-service/errs"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
 // GeneticDataRepo implements the storage.GeneticDataRepoI interface for MongoDB.
+// Data values are stored encrypted with the data keys of the user.
 type GeneticDataRepo struct {
-	db *mongo.Database
+	db     *mongo.Database
+	cipher *encryption.Cipher
 }
 
 // NewGeneticDataRepo creates a new GeneticDataRepo instance.
-func NewGeneticDataRepo(db *mongo.Database) *GeneticDataRepo {
+func NewGeneticDataRepo(db *mongo.Database, cipher *encryption.Cipher) *GeneticDataRepo {
 	return &GeneticDataRepo{
-		db: db,
+		db:     db,
+		cipher: cipher,
 	}
 }
 
 // CreateGeneticData creates a new genetic data record in the database.
-func (r *GeneticDataRepo) CreateGeneticData(ctx context.Context, data *health.GeneticData) (string, error) {
+func (r *GeneticDataRepo) CreateGeneticData(ctx context.Context, data *health.GeneticData) (*health.GeneticData, error) {
 	var (
 		objectID primitive.ObjectID
 		err      error
@@ -37,15 +41,20 @@ func (r *GeneticDataRepo) CreateGeneticData(ctx context.Context, data *health.Ge
 	if data.Id != "" {
 		objectID, err = primitive.ObjectIDFromHex(data.Id)
 		if err != nil {
-			return "", err
+			return nil, errs.InvalidArgument("id", "invalid genetic data ID: %v", err)
 		}
 	} else {
 		objectID = primitive.NewObjectID()
 	}
-	// Convert the Any proto message to a BSON document
-	dataVal, err := protojson.Marshal(data.DataValue)
+	// Convert the Any proto message to encrypted JSON
+	dataVal, err := r.encryptDataValue(ctx, data.UserId, data.DataValue)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	analysisDate, err := dateValue("analysis_date", data.AnalysisDate)
+	if err != nil {
+		return nil, err
 	}
 
 	// Convert the model to a BSON document
@@ -53,24 +62,28 @@ func (r *GeneticDataRepo) CreateGeneticData(ctx context.Context, data *health.Ge
 		"_id":           objectID,
 		"user_id":       data.UserId,
 		"data_type":     data.DataType,
-		"data_value":    string(dataVal),
-		"analysis_date": data.AnalysisDate,
+		"data_value":    dataVal,
+		"analysis_date": analysisDate,
 		"created_at":    time.Now(),
 		"updated_at":    time.Now(),
+		versionField:    int64(1),
 	}
 
 	// Insert the document into the collection
 	result, err := r.db.Collection("genetic_data").InsertOne(ctx, bsonData)
 	if err != nil {
-		return "", fmt.Errorf("failed to create genetic data: %w", err)
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, errs.AlreadyExists("genetic data", objectID.Hex())
+		}
+		return nil, errs.Wrap(err, "failed to create genetic data")
 	}
 
 	// Get the inserted ID as a string
 	insertedID, ok := result.InsertedID.(primitive.ObjectID)
 	if !ok {
-		return "", fmt.Errorf("failed to convert inserted ID to string")
+		return nil, fmt.Errorf("failed to convert inserted ID to string")
 	}
-	return insertedID.Hex(), nil
+	return r.GetGeneticData(ctx, insertedID.Hex())
 }
 
 // GetGeneticData retrieves a genetic data record by its ID.
@@ -78,120 +91,172 @@ func (r *GeneticDataRepo) GetGeneticData(ctx context.Context, id string) (*healt
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid genetic data ID: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid genetic data ID: %v", err)
 	}
 
 	// Find the document by ID
 	var bsonData bson.M
-	err = r.db.Collection("genetic_data").FindOne(ctx, bson.M{"_id": objID}).Decode(&bsonData)
+	err = r.db.Collection("genetic_data").FindOne(ctx, byID(ctx, objID)).Decode(&bsonData)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, status.Errorf(codes.NotFound, "genetic data not found")
+			return nil, errs.NotFound("genetic data", objID.Hex())
 		}
-		return nil, fmt.Errorf("failed to get genetic data by ID: %w", err)
+		return nil, errs.Wrap(err, "failed to get genetic data by ID")
 	}
 
 	// Convert the BSON document to a proto message
-	dataModel, err := bsonToGeneticData(bsonData)
+	dataModel, err := r.toGeneticData(ctx, bsonData)
 	if err != nil {
 		return nil, err
 	}
 	return dataModel, nil
 }
 
-// UpdateGeneticData updates an existing genetic data record in the database.
-func (r *GeneticDataRepo) UpdateGeneticData(ctx context.Context, data *health.GeneticData) error {
+// UpdateGeneticData updates an existing genetic data record in the database. With an
+// update_mask, only the masked fields are written.
+func (r *GeneticDataRepo) UpdateGeneticData(ctx context.Context, data *health.GeneticData) (*health.GeneticData, error) {
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(data.Id)
 	if err != nil {
-		return fmt.Errorf("invalid genetic data ID: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid genetic data ID: %v", err)
 	}
-	dataVal, err := protojson.Marshal(data.DataValue)
-	if err != nil {
-		return err
-	}
-	// Convert the model to a BSON document
+	masked := func(field string) bool { return storage.MaskIncludes(data.UpdateMask, field) }
+
+	// Convert the masked fields of the model to a BSON document
 	bsonData := bson.M{
-		"user_id":       data.UserId,
-		"data_type":     data.DataType,
-		"data_value":    string(dataVal),
-		"analysis_date": data.AnalysisDate,
-		"updated_at":    time.Now(),
+		"updated_at": time.Now(),
 	}
-
-	// Update the document in the collection
-	result, err := r.db.Collection("genetic_data").UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": bsonData})
-	if err != nil {
-		return fmt.Errorf("failed to update genetic data: %w", err)
+	if masked("user_id") {
+		bsonData["user_id"] = data.UserId
+	}
+	if masked("data_type") {
+		bsonData["data_type"] = data.DataType
+	}
+	if masked("analysis_date") {
+		if bsonData["analysis_date"], err = dateValue("analysis_date", data.AnalysisDate); err != nil {
+			return nil, err
+		}
 	}
 
-	if result.ModifiedCount == 0 {
-		return status.Errorf(codes.NotFound, "genetic data not found")
+	// Update the document in the collection, unless it changed since it was read
+	updated, err := updateVersioned(ctx, r.db.Collection("genetic_data"), "genetic data", objID, data.ExpectedVersion,
+		func(current bson.M, _ int64) (bson.M, error) {
+			if masked("data_value") {
+				// Encrypt with the keys of the owner after the update
+				owner := data.UserId
+				if !masked("user_id") {
+					owner, _ = current["user_id"].(string)
+				}
+				dataVal, err := r.encryptDataValue(ctx, owner, data.DataValue)
+				if err != nil {
+					return nil, err
+				}
+				bsonData["data_value"] = dataVal
+			}
+			return bson.M{"$set": bsonData}, nil
+		})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return r.toGeneticData(ctx, updated)
 }
 
-// DeleteGeneticData deletes a genetic data record from the database.
+// DeleteGeneticData soft-deletes a genetic data record. It is excluded from all reads and can be
+// restored until it is purged by the retention job.
 func (r *GeneticDataRepo) DeleteGeneticData(ctx context.Context, id string) error {
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return fmt.Errorf("invalid genetic data ID: %w", err)
+		return errs.InvalidArgument("id", "invalid genetic data ID: %v", err)
 	}
 
-	// Delete the document from the collection
-	result, err := r.db.Collection("genetic_data").DeleteOne(ctx, bson.M{"_id": objID})
+	return softDelete(ctx, r.db.Collection("genetic_data"), "genetic data", objID)
+}
+
+// RestoreGeneticData restores a genetic data record that was soft-deleted and not purged yet.
+func (r *GeneticDataRepo) RestoreGeneticData(ctx context.Context, id string) (*health.GeneticData, error) {
+	// Convert the string ID to an ObjectID
+	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return fmt.Errorf("failed to delete genetic data: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid genetic data ID: %v", err)
 	}
 
-	if result.DeletedCount == 0 {
-		return status.Errorf(codes.NotFound, "genetic data not found")
+	restored, err := restore(ctx, r.db.Collection("genetic_data"), "genetic data", objID)
+	if err != nil {
+		return nil, err
 	}
-
-	return nil
+	return r.toGeneticData(ctx, restored)
 }
 
 // ListGeneticData retrieves all genetic data records for a given user ID, applying filters if provided.
-func (r *GeneticDataRepo) ListGeneticData(ctx context.Context, req *health.ListGeneticDataRequest) ([]*health.GeneticData, error) {
+func (r *GeneticDataRepo) ListGeneticData(ctx context.Context, req *health.ListGeneticDataRequest) (*health.ListGeneticDataResponse, error) {
+	if err := storage.RejectPayloadFilters(req.DataValueFilters); err != nil {
+		return nil, err
+	}
+
 	// Build the filter query based on the request parameters
-	filter := bson.M{}
+	filter := notDeleted(bson.M{})
 	if req.UserId != "" {
 		filter["user_id"] = req.UserId
 	}
 	if req.DataType != "" {
 		filter["data_type"] = req.DataType
 	}
-	if req.AnalysisDate != "" {
-		filter["analysis_date"] = req.AnalysisDate
+	if err := addDateRangeFilter(filter, "analysis_date", req.AnalysisDate, req.AnalysisDateFrom, req.AnalysisDateTo); err != nil {
+		return nil, err
 	}
 
-	// Find the documents based on the filter
-	cursor, err := r.db.Collection("genetic_data").Find(ctx, filter)
+	page, err := newPageRequest(req.PageSize, req.PageToken, req.OrderBy)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list genetic data: %w", err)
+		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	var geneticDataRecords []*health.GeneticData
+	// Find the documents of the requested page
+	docs, nextPageToken, totalSize, err := findPage(ctx, r.db.Collection("genetic_data"), filter, page)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list genetic data")
+	}
 
-	// Iterate through the cursor and convert each document to a proto message
-	for cursor.Next(ctx) {
-		var bsonData bson.M
-		if err := cursor.Decode(&bsonData); err != nil {
-			return nil, fmt.Errorf("failed to decode genetic data: %w", err)
-		}
+	response := &health.ListGeneticDataResponse{
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}
 
-		dataModel, err := bsonToGeneticData(bsonData)
+	// Convert each document to a proto message
+	for _, bsonData := range docs {
+		dataModel, err := r.toGeneticData(ctx, bsonData)
 		if err != nil {
 			return nil, err
 		}
 
-		geneticDataRecords = append(geneticDataRecords, dataModel)
+		response.GeneticData = append(response.GeneticData, dataModel)
 	}
 
-	return geneticDataRecords, nil
+	return response, nil
+}
+
+// encryptDataValue marshals the data_value of genetic data to JSON and encrypts it
+// with the data key of its user. Unlike other payloads it is not stored as a BSON
+// sub-document, since its fields must not be readable by the database.
+func (r *GeneticDataRepo) encryptDataValue(ctx context.Context, userID string, dataValue *anypb.Any) (string, error) {
+	dataVal, err := protojson.Marshal(dataValue)
+	if err != nil {
+		return "", err
+	}
+	encrypted, err := r.cipher.Encrypt(ctx, userID, dataValueField, string(dataVal))
+	if err != nil {
+		return "", errs.Wrap(err, "failed to encrypt data_value")
+	}
+	return encrypted, nil
+}
+
+// toGeneticData decrypts stored genetic data and converts it to a proto message.
+func (r *GeneticDataRepo) toGeneticData(ctx context.Context, bsonData bson.M) (*health.GeneticData, error) {
+	if err := decryptGeneticDataFields(ctx, r.cipher, bsonData); err != nil {
+		return nil, err
+	}
+	return bsonToGeneticData(bsonData)
 }
 
 // bsonToGeneticData converts a BSON document to a health.GeneticData proto message.
@@ -214,15 +279,13 @@ func bsonToGeneticData(bsonData bson.M) (*health.GeneticData, error) {
 	if val, ok := bsonData["data_type"].(string); ok {
 		dataModel.DataType = val
 	}
-	if val, ok := bsonData["analysis_date"].(string); ok {
-		dataModel.AnalysisDate = val
-	}
+	dataModel.AnalysisDate = formatDate(bsonData["analysis_date"])
 	// Convert data_value from BSON to Any proto message
 	if val, ok := bsonData["data_value"].(string); ok { // Correct type assertion here
 		dataModel.DataValue = &anypb.Any{}
 		err := protojson.Unmarshal([]byte(val), dataModel.DataValue)
 		if err != nil {
-			return nil, fmt.Errorf("failed to unmarshal data_value from JSON: %w", err)
+			return nil, errs.Wrap(err, "failed to unmarshal data_value from JSON")
 		}
 	}
 	// Convert created_at and updated_at fields
@@ -232,6 +295,7 @@ func bsonToGeneticData(bsonData bson.M) (*health.GeneticData, error) {
 	if val, ok := bsonData["updated_at"].(primitive.DateTime); ok {
 		dataModel.UpdatedAt = val.Time().Format(time.RFC3339)
 	}
+	dataModel.Version = documentVersion(bsonData)
 
 	return dataModel, nil
 }
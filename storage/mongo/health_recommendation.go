@@ -5,12 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/health-analytics-service/health-analytics-service/errs"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // HealthRecommendationRepo implements the storage.HealthRecommendationRepoI interface for MongoDB.
@@ -26,7 +26,7 @@ func NewHealthRecommendationRepo(db *mongo.Database) *HealthRecommendationRepo {
 }
 
 // CreateHealthRecommendation creates a new health recommendation in the database.
-func (r *HealthRecommendationRepo) CreateHealthRecommendation(ctx context.Context, recommendation *health.HealthRecommendation) (string, error) {
+func (r *HealthRecommendationRepo) CreateHealthRecommendation(ctx context.Context, recommendation *health.HealthRecommendation) (*health.HealthRecommendation, error) {
 	var (
 		objectID primitive.ObjectID
 		err      error
@@ -35,7 +35,7 @@ func (r *HealthRecommendationRepo) CreateHealthRecommendation(ctx context.Contex
 	if recommendation.Id != "" {
 		objectID, err = primitive.ObjectIDFromHex(recommendation.Id)
 		if err != nil {
-			return "", err
+			return nil, errs.InvalidArgument("id", "invalid health recommendation ID: %v", err)
 		}
 	} else {
 		objectID = primitive.NewObjectID()
@@ -50,20 +50,24 @@ func (r *HealthRecommendationRepo) CreateHealthRecommendation(ctx context.Contex
 		"priority":            recommendation.Priority,
 		"created_at":          time.Now(),
 		"updated_at":          time.Now(),
+		versionField:          int64(1),
 	}
 
 	// Insert the document into the collection
 	result, err := r.db.Collection("health_recommendations").InsertOne(ctx, bsonRecommendation)
 	if err != nil {
-		return "", fmt.Errorf("failed to create health recommendation: %w", err)
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, errs.AlreadyExists("health recommendation", objectID.Hex())
+		}
+		return nil, errs.Wrap(err, "failed to create health recommendation")
 	}
 
 	// Get the inserted ID as a string
 	insertedID, ok := result.InsertedID.(primitive.ObjectID)
 	if !ok {
-		return "", fmt.Errorf("failed to convert inserted ID to string")
+		return nil, fmt.Errorf("failed to convert inserted ID to string")
 	}
-	return insertedID.Hex(), nil
+	return r.GetHealthRecommendation(ctx, insertedID.Hex())
 }
 
 // GetHealthRecommendation retrieves a health recommendation by its ID.
@@ -71,17 +75,17 @@ func (r *HealthRecommendationRepo) GetHealthRecommendation(ctx context.Context,
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid health recommendation ID: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid health recommendation ID: %v", err)
 	}
 
 	// Find the document by ID
 	var bsonRecommendation bson.M
-	err = r.db.Collection("health_recommendations").FindOne(ctx, bson.M{"_id": objID}).Decode(&bsonRecommendation)
+	err = r.db.Collection("health_recommendations").FindOne(ctx, byID(ctx, objID)).Decode(&bsonRecommendation)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, status.Errorf(codes.NotFound, "health recommendation not found")
+			return nil, errs.NotFound("health recommendation", objID.Hex())
 		}
-		return nil, fmt.Errorf("failed to get health recommendation by ID: %w", err)
+		return nil, errs.Wrap(err, "failed to get health recommendation by ID")
 	}
 
 	// Convert the BSON document to a proto message
@@ -94,60 +98,73 @@ func (r *HealthRecommendationRepo) GetHealthRecommendation(ctx context.Context,
 }
 
 // UpdateHealthRecommendation updates an existing health recommendation in the database.
-func (r *HealthRecommendationRepo) UpdateHealthRecommendation(ctx context.Context, recommendation *health.HealthRecommendation) error {
+// With an update_mask, only the masked fields are written.
+func (r *HealthRecommendationRepo) UpdateHealthRecommendation(ctx context.Context, recommendation *health.HealthRecommendation) (*health.HealthRecommendation, error) {
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(recommendation.Id)
 	if err != nil {
-		return fmt.Errorf("invalid health recommendation ID: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid health recommendation ID: %v", err)
 	}
 
-	// Convert the model to a BSON document
+	// Convert the masked fields of the model to a BSON document
 	bsonRecommendation := bson.M{
+		"updated_at": time.Now(),
+	}
+	fields := bson.M{
 		"user_id":             recommendation.UserId,
 		"recommendation_type": recommendation.RecommendationType,
 		"description":         recommendation.Description,
 		"priority":            recommendation.Priority,
-		"updated_at":          time.Now(),
 	}
-
-	// Update the document in the collection
-	result, err := r.db.Collection("health_recommendations").UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": bsonRecommendation})
-	if err != nil {
-		return fmt.Errorf("failed to update health recommendation: %w", err)
+	for field, value := range fields {
+		if storage.MaskIncludes(recommendation.UpdateMask, field) {
+			bsonRecommendation[field] = value
+		}
 	}
 
-	if result.ModifiedCount == 0 {
-		return status.Errorf(codes.NotFound, "health recommendation not found")
+	// Update the document in the collection, unless it changed since it was read
+	updated, err := updateVersioned(ctx, r.db.Collection("health_recommendations"), "health recommendation", objID, recommendation.ExpectedVersion,
+		func(bson.M, int64) (bson.M, error) {
+			return bson.M{"$set": bsonRecommendation}, nil
+		})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return bsonToHealthRecommendation(updated)
 }
 
-// DeleteHealthRecommendation deletes a health recommendation from the database.
+// DeleteHealthRecommendation soft-deletes a health recommendation. It is excluded from all reads and can be
+// restored until it is purged by the retention job.
 func (r *HealthRecommendationRepo) DeleteHealthRecommendation(ctx context.Context, id string) error {
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return fmt.Errorf("invalid health recommendation ID: %w", err)
+		return errs.InvalidArgument("id", "invalid health recommendation ID: %v", err)
 	}
 
-	// Delete the document from the collection
-	result, err := r.db.Collection("health_recommendations").DeleteOne(ctx, bson.M{"_id": objID})
+	return softDelete(ctx, r.db.Collection("health_recommendations"), "health recommendation", objID)
+}
+
+// RestoreHealthRecommendation restores a health recommendation that was soft-deleted and not purged yet.
+func (r *HealthRecommendationRepo) RestoreHealthRecommendation(ctx context.Context, id string) (*health.HealthRecommendation, error) {
+	// Convert the string ID to an ObjectID
+	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return fmt.Errorf("failed to delete health recommendation: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid health recommendation ID: %v", err)
 	}
 
-	if result.DeletedCount == 0 {
-		return status.Errorf(codes.NotFound, "health recommendation not found")
+	restored, err := restore(ctx, r.db.Collection("health_recommendations"), "health recommendation", objID)
+	if err != nil {
+		return nil, err
 	}
-
-	return nil
+	return bsonToHealthRecommendation(restored)
 }
 
 // ListHealthRecommendations retrieves all health recommendations for a given user ID, applying filters if provided.
-func (r *HealthRecommendationRepo) ListHealthRecommendations(ctx context.Context, req *health.ListHealthRecommendationsRequest) ([]*health.HealthRecommendation, error) {
+func (r *HealthRecommendationRepo) ListHealthRecommendations(ctx context.Context, req *health.ListHealthRecommendationsRequest) (*health.ListHealthRecommendationsResponse, error) {
 	// Build the filter query based on the request parameters
-	filter := bson.M{}
+	filter := notDeleted(bson.M{})
 	if req.UserId != "" {
 		filter["user_id"] = req.UserId
 	}
@@ -158,31 +175,33 @@ func (r *HealthRecommendationRepo) ListHealthRecommendations(ctx context.Context
 		filter["priority"] = req.Priority
 	}
 
-	// Find the documents based on the filter
-	cursor, err := r.db.Collection("health_recommendations").Find(ctx, filter)
+	page, err := newPageRequest(req.PageSize, req.PageToken, req.OrderBy)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list health recommendations: %w", err)
+		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	var healthRecommendations []*health.HealthRecommendation
+	// Find the documents of the requested page
+	docs, nextPageToken, totalSize, err := findPage(ctx, r.db.Collection("health_recommendations"), filter, page)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list health recommendations")
+	}
 
-	// Iterate through the cursor and convert each document to a proto message
-	for cursor.Next(ctx) {
-		var bsonRecommendation bson.M
-		if err := cursor.Decode(&bsonRecommendation); err != nil {
-			return nil, fmt.Errorf("failed to decode health recommendation: %w", err)
-		}
+	response := &health.ListHealthRecommendationsResponse{
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}
 
+	// Convert each document to a proto message
+	for _, bsonRecommendation := range docs {
 		recommendationModel, err := bsonToHealthRecommendation(bsonRecommendation)
 		if err != nil {
 			return nil, err
 		}
 
-		healthRecommendations = append(healthRecommendations, recommendationModel)
+		response.HealthRecommendations = append(response.HealthRecommendations, recommendationModel)
 	}
 
-	return healthRecommendations, nil
+	return response, nil
 }
 
 // bsonToHealthRecommendation converts a BSON document to a health.HealthRecommendation proto message.
@@ -219,6 +238,7 @@ func bsonToHealthRecommendation(bsonRecommendation bson.M) (*health.HealthRecomm
 	if val, ok := bsonRecommendation["updated_at"].(primitive.DateTime); ok {
 		recommendationModel.UpdatedAt = val.Time().Format(time.RFC3339)
 	}
+	recommendationModel.Version = documentVersion(bsonRecommendation)
 
 	return recommendationModel, nil
 }
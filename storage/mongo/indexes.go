@@ -0,0 +1,252 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// indexSpec declares an index of a collection. Its name is derived from the keys the
+// way MongoDB names indexes by default, e.g. user_id_1_created_at_-1.
+type indexSpec struct {
+	keys   bson.D
+	unique bool
+	sparse bool
+}
+
+// name returns the name of the index.
+func (s indexSpec) name() string {
+	return indexName(s.keys)
+}
+
+// listOrder is the order of paginated list queries (see findPage), prefixed with the
+// equality fields of their filters so that pages are read in index order.
+func listOrder(fields ...string) bson.D {
+	keys := bson.D{}
+	for _, field := range fields {
+		keys = append(keys, bson.E{Key: field, Value: 1})
+	}
+	return append(keys, bson.E{Key: "created_at", Value: -1}, bson.E{Key: "_id", Value: -1})
+}
+
+// deletedAtIndex serves the retention purge. It is sparse because only soft-deleted
+// documents carry deleted_at.
+var deletedAtIndex = indexSpec{keys: bson.D{{Key: deletedAtField, Value: 1}}, sparse: true}
+
+// collectionIndexes declares the indexes of every collection, following the filters
+// of the list, summary, version history and key lookup queries.
+var collectionIndexes = map[string][]indexSpec{
+	"medical_records": {
+		{keys: listOrder("user_id")},
+		{keys: listOrder("doctor_id")},
+		{keys: bson.D{{Key: "user_id", Value: 1}, {Key: "record_type", Value: 1}, {Key: "record_date", Value: -1}}},
+		{keys: bson.D{{Key: descriptionIndexField, Value: 1}}, sparse: true},
+		deletedAtIndex,
+	},
+	"medical_records_history": {
+		{keys: bson.D{{Key: "record_id", Value: 1}, {Key: versionField, Value: -1}}, unique: true},
+	},
+	"genetic_data": {
+		{keys: listOrder("user_id")},
+		{keys: listOrder("user_id", "data_type")},
+		deletedAtIndex,
+	},
+	"lifestyle_data": {
+		{keys: listOrder("user_id")},
+		{keys: bson.D{{Key: "user_id", Value: 1}, {Key: "data_type", Value: 1}, {Key: "recorded_date", Value: -1}}},
+		deletedAtIndex,
+	},
+	"wearable_data": {
+		{keys: listOrder("user_id")},
+		{keys: bson.D{{Key: "user_id", Value: 1}, {Key: "data_type", Value: 1}, {Key: "recorded_timestamp", Value: -1}}},
+		{keys: bson.D{{Key: "user_id", Value: 1}, {Key: "device_type", Value: 1}, {Key: "recorded_timestamp", Value: -1}}},
+		deletedAtIndex,
+	},
+	"health_recommendations": {
+		{keys: listOrder("user_id")},
+		{keys: bson.D{{Key: "user_id", Value: 1}, {Key: "recommendation_type", Value: 1}, {Key: "priority", Value: 1}}},
+		deletedAtIndex,
+	},
+	"audit_log": {
+		{keys: listOrder()},
+		{keys: listOrder("user_id")},
+		{keys: listOrder("actor")},
+		{keys: listOrder("resource_type", "resource_id")},
+	},
+	"data_keys": {
+		{keys: bson.D{{Key: "user_id", Value: 1}, {Key: "version", Value: -1}}},
+	},
+}
+
+// IndexDrift describes an existing index that does not match its declaration, or
+// that is not declared at all.
+type IndexDrift struct {
+	Collection string
+	Index      string // Name of the existing index
+	Reason     string
+}
+
+func (d IndexDrift) String() string {
+	return fmt.Sprintf("%s.%s: %s", d.Collection, d.Index, d.Reason)
+}
+
+// IndexReport is the outcome of EnsureIndexes.
+type IndexReport struct {
+	Created []string // Indexes created, as collection.name
+	Drift   []IndexDrift
+}
+
+// EnsureIndexes reconciles the indexes of all collections with their declarations:
+// missing indexes are created and existing ones are compared to what is declared.
+// It is idempotent. Drifted indexes are reported, never dropped, since rebuilding an
+// index of a large collection is an operational decision.
+func EnsureIndexes(ctx context.Context, db *mongo.Database) (*IndexReport, error) {
+	report := &IndexReport{}
+
+	collections := make([]string, 0, len(collectionIndexes))
+	for collection := range collectionIndexes {
+		collections = append(collections, collection)
+	}
+	sort.Strings(collections)
+
+	for _, collection := range collections {
+		if err := ensureCollectionIndexes(ctx, db.Collection(collection), collectionIndexes[collection], report); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// existingIndex is the stored form of an index as listed by MongoDB.
+type existingIndex struct {
+	Name   string `bson:"name"`
+	Key    bson.D `bson:"key"`
+	Unique bool   `bson:"unique"`
+	Sparse bool   `bson:"sparse"`
+}
+
+// ensureCollectionIndexes reconciles the indexes of a single collection.
+func ensureCollectionIndexes(ctx context.Context, collection *mongo.Collection, specs []indexSpec, report *IndexReport) error {
+	var existing []existingIndex
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil && !isNamespaceNotFound(err) {
+		return errs.Wrap(err, "failed to list indexes of %s", collection.Name())
+	}
+	if err == nil {
+		if err := cursor.All(ctx, &existing); err != nil {
+			return errs.Wrap(err, "failed to decode indexes of %s", collection.Name())
+		}
+	}
+
+	byName := make(map[string]existingIndex)
+	byKeys := make(map[string]existingIndex)
+	for _, index := range existing {
+		byName[index.Name] = index
+		byKeys[indexName(index.Key)] = index
+	}
+
+	declared := make(map[string]bool)
+	var missing []mongo.IndexModel
+	for _, spec := range specs {
+		name := spec.name()
+		declared[name] = true
+
+		index, ok := byName[name]
+		if !ok {
+			// The same keys under another name would make the creation fail
+			if other, ok := byKeys[name]; ok {
+				declared[other.Name] = true
+				report.Drift = append(report.Drift, IndexDrift{
+					Collection: collection.Name(),
+					Index:      other.Name,
+					Reason:     fmt.Sprintf("declared as %s", name),
+				})
+				continue
+			}
+			missing = append(missing, mongo.IndexModel{
+				Keys:    spec.keys,
+				Options: options.Index().SetName(name).SetUnique(spec.unique).SetSparse(spec.sparse),
+			})
+			continue
+		}
+
+		var differences []string
+		if indexName(index.Key) != name {
+			differences = append(differences, "keys are "+indexName(index.Key))
+		}
+		if index.Unique != spec.unique {
+			differences = append(differences, fmt.Sprintf("unique is %t, not %t", index.Unique, spec.unique))
+		}
+		if index.Sparse != spec.sparse {
+			differences = append(differences, fmt.Sprintf("sparse is %t, not %t", index.Sparse, spec.sparse))
+		}
+		if len(differences) > 0 {
+			report.Drift = append(report.Drift, IndexDrift{
+				Collection: collection.Name(),
+				Index:      name,
+				Reason:     strings.Join(differences, ", "),
+			})
+		}
+	}
+
+	for _, index := range existing {
+		if index.Name != "_id_" && !declared[index.Name] {
+			report.Drift = append(report.Drift, IndexDrift{
+				Collection: collection.Name(),
+				Index:      index.Name,
+				Reason:     "not declared",
+			})
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+	names, err := collection.Indexes().CreateMany(ctx, missing)
+	if err != nil {
+		return errs.Wrap(err, "failed to create indexes of %s", collection.Name())
+	}
+	for _, name := range names {
+		report.Created = append(report.Created, collection.Name()+"."+name)
+	}
+	return nil
+}
+
+// isNamespaceNotFound reports whether err is caused by a collection that does not
+// exist yet, which has no indexes.
+func isNamespaceNotFound(err error) bool {
+	var cmdErr mongo.CommandError
+	return errors.As(err, &cmdErr) && cmdErr.Code == 26
+}
+
+// indexName returns the default MongoDB name of an index with the given keys.
+func indexName(keys bson.D) string {
+	parts := make([]string, 0, 2*len(keys))
+	for _, key := range keys {
+		// Directions read back from the server may be doubles
+		direction := fmt.Sprint(key.Value)
+		if v, ok := key.Value.(float64); ok {
+			direction = fmt.Sprint(int64(v))
+		}
+		parts = append(parts, key.Key, direction)
+	}
+	return strings.Join(parts, "_")
+}
+
+// logIndexReport logs the outcome of EnsureIndexes.
+func logIndexReport(report *IndexReport) {
+	for _, created := range report.Created {
+		slog.Info("Created MongoDB index " + created)
+	}
+	for _, drift := range report.Drift {
+		slog.Warn("MongoDB index drift: " + drift.String())
+	}
+}
@@ -5,14 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/health-analytics-service/health-analytics-service/errs"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/encoding/protojson"
-	"google.golang.org/protobuf/types/known/anypb"
 )
 
 // LifestyleDataRepo implements the storage.LifestyleDataRepoI interface for MongoDB.
@@ -28,7 +26,7 @@ func NewLifestyleDataRepo(db *mongo.Database) *LifestyleDataRepo {
 }
 
 // CreateLifestyleData creates a new lifestyle data record in the database.
-func (r *LifestyleDataRepo) CreateLifestyleData(ctx context.Context, data *health.LifestyleData) (string, error) {
+func (r *LifestyleDataRepo) CreateLifestyleData(ctx context.Context, data *health.LifestyleData) (*health.LifestyleData, error) {
 	var (
 		objectID primitive.ObjectID
 		err      error
@@ -37,16 +35,21 @@ func (r *LifestyleDataRepo) CreateLifestyleData(ctx context.Context, data *healt
 	if data.Id != "" {
 		objectID, err = primitive.ObjectIDFromHex(data.Id)
 		if err != nil {
-			return "", err
+			return nil, errs.InvalidArgument("id", "invalid lifestyle data ID: %v", err)
 		}
 	} else {
 		objectID = primitive.NewObjectID()
 	}
 
-	// Convert the Any proto message to a JSON string
-	dataValueJSON, err := protojson.Marshal(data.DataValue)
+	// Store the Any proto message as a BSON sub-document
+	dataValue, err := encodeDataValue(data.DataValue)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	recordedDate, err := dateValue("recorded_date", data.RecordedDate)
+	if err != nil {
+		return nil, err
 	}
 
 	// Convert the model to a BSON document
@@ -54,24 +57,28 @@ func (r *LifestyleDataRepo) CreateLifestyleData(ctx context.Context, data *healt
 		"_id":           objectID,
 		"user_id":       data.UserId,
 		"data_type":     data.DataType,
-		"data_value":    string(dataValueJSON),
-		"recorded_date": data.RecordedDate,
+		"data_value":    dataValue,
+		"recorded_date": recordedDate,
 		"created_at":    time.Now(),
 		"updated_at":    time.Now(),
+		versionField:    int64(1),
 	}
 
 	// Insert the document into the collection
 	result, err := r.db.Collection("lifestyle_data").InsertOne(ctx, bsonData)
 	if err != nil {
-		return "", fmt.Errorf("failed to create lifestyle data: %w", err)
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, errs.AlreadyExists("lifestyle data", objectID.Hex())
+		}
+		return nil, errs.Wrap(err, "failed to create lifestyle data")
 	}
 
 	// Get the inserted ID as a string
 	insertedID, ok := result.InsertedID.(primitive.ObjectID)
 	if !ok {
-		return "", fmt.Errorf("failed to convert inserted ID to string")
+		return nil, fmt.Errorf("failed to convert inserted ID to string")
 	}
-	return insertedID.Hex(), nil
+	return r.GetLifestyleData(ctx, insertedID.Hex())
 }
 
 // GetLifestyleData retrieves a lifestyle data record by its ID.
@@ -79,17 +86,17 @@ func (r *LifestyleDataRepo) GetLifestyleData(ctx context.Context, id string) (*h
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid lifestyle data ID: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid lifestyle data ID: %v", err)
 	}
 
 	// Find the document by ID
 	var bsonData bson.M
-	err = r.db.Collection("lifestyle_data").FindOne(ctx, bson.M{"_id": objID}).Decode(&bsonData)
+	err = r.db.Collection("lifestyle_data").FindOne(ctx, byID(ctx, objID)).Decode(&bsonData)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, status.Errorf(codes.NotFound, "lifestyle data not found")
+			return nil, errs.NotFound("lifestyle data", objID.Hex())
 		}
-		return nil, fmt.Errorf("failed to get lifestyle data by ID: %w", err)
+		return nil, errs.Wrap(err, "failed to get lifestyle data by ID")
 	}
 
 	// Convert the BSON document to a proto message
@@ -100,102 +107,122 @@ func (r *LifestyleDataRepo) GetLifestyleData(ctx context.Context, id string) (*h
 	return dataModel, nil
 }
 
-// UpdateLifestyleData updates an existing lifestyle data record in the database.
-func (r *LifestyleDataRepo) UpdateLifestyleData(ctx context.Context, data *health.LifestyleData) error {
+// UpdateLifestyleData updates an existing lifestyle data record in the database. With an
+// update_mask, only the masked fields are written.
+func (r *LifestyleDataRepo) UpdateLifestyleData(ctx context.Context, data *health.LifestyleData) (*health.LifestyleData, error) {
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(data.Id)
 	if err != nil {
-		return fmt.Errorf("invalid lifestyle data ID: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid lifestyle data ID: %v", err)
 	}
 
-	// Convert the Any proto message to a JSON string
-	dataValueJSON, err := protojson.Marshal(data.DataValue)
-	if err != nil {
-		return err
-	}
+	masked := func(field string) bool { return storage.MaskIncludes(data.UpdateMask, field) }
 
-	// Convert the model to a BSON document
+	// Convert the masked fields of the model to a BSON document
 	bsonData := bson.M{
-		"user_id":       data.UserId,
-		"data_type":     data.DataType,
-		"data_value":    string(dataValueJSON), // Store as string
-		"recorded_date": data.RecordedDate,
-		"updated_at":    time.Now(),
+		"updated_at": time.Now(),
 	}
-
-	// Update the document in the collection
-	result, err := r.db.Collection("lifestyle_data").UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": bsonData})
-	if err != nil {
-		return fmt.Errorf("failed to update lifestyle data: %w", err)
+	if masked("user_id") {
+		bsonData["user_id"] = data.UserId
+	}
+	if masked("data_type") {
+		bsonData["data_type"] = data.DataType
+	}
+	if masked("data_value") {
+		// Store the Any proto message as a BSON sub-document
+		if bsonData["data_value"], err = encodeDataValue(data.DataValue); err != nil {
+			return nil, err
+		}
+	}
+	if masked("recorded_date") {
+		if bsonData["recorded_date"], err = dateValue("recorded_date", data.RecordedDate); err != nil {
+			return nil, err
+		}
 	}
 
-	if result.ModifiedCount == 0 {
-		return status.Errorf(codes.NotFound, "lifestyle data not found")
+	// Update the document in the collection, unless it changed since it was read
+	updated, err := updateVersioned(ctx, r.db.Collection("lifestyle_data"), "lifestyle data", objID, data.ExpectedVersion,
+		func(bson.M, int64) (bson.M, error) {
+			return bson.M{"$set": bsonData}, nil
+		})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return bsonToLifestyleData(updated)
 }
 
-// DeleteLifestyleData deletes a lifestyle data record from the database.
+// DeleteLifestyleData soft-deletes a lifestyle data record. It is excluded from all reads and can be
+// restored until it is purged by the retention job.
 func (r *LifestyleDataRepo) DeleteLifestyleData(ctx context.Context, id string) error {
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return fmt.Errorf("invalid lifestyle data ID: %w", err)
+		return errs.InvalidArgument("id", "invalid lifestyle data ID: %v", err)
 	}
 
-	// Delete the document from the collection
-	result, err := r.db.Collection("lifestyle_data").DeleteOne(ctx, bson.M{"_id": objID})
+	return softDelete(ctx, r.db.Collection("lifestyle_data"), "lifestyle data", objID)
+}
+
+// RestoreLifestyleData restores a lifestyle data record that was soft-deleted and not purged yet.
+func (r *LifestyleDataRepo) RestoreLifestyleData(ctx context.Context, id string) (*health.LifestyleData, error) {
+	// Convert the string ID to an ObjectID
+	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return fmt.Errorf("failed to delete lifestyle data: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid lifestyle data ID: %v", err)
 	}
 
-	if result.DeletedCount == 0 {
-		return status.Errorf(codes.NotFound, "lifestyle data not found")
+	restored, err := restore(ctx, r.db.Collection("lifestyle_data"), "lifestyle data", objID)
+	if err != nil {
+		return nil, err
 	}
-
-	return nil
+	return bsonToLifestyleData(restored)
 }
 
 // ListLifestyleData retrieves all lifestyle data records for a given user ID, applying filters if provided.
-func (r *LifestyleDataRepo) ListLifestyleData(ctx context.Context, req *health.ListLifestyleDataRequest) ([]*health.LifestyleData, error) {
+func (r *LifestyleDataRepo) ListLifestyleData(ctx context.Context, req *health.ListLifestyleDataRequest) (*health.ListLifestyleDataResponse, error) {
 	// Build the filter query based on the request parameters
-	filter := bson.M{}
+	filter := notDeleted(bson.M{})
 	if req.UserId != "" {
 		filter["user_id"] = req.UserId
 	}
 	if req.DataType != "" {
 		filter["data_type"] = req.DataType
 	}
-	if req.RecordedDate != "" {
-		filter["recorded_date"] = req.RecordedDate
+	if err := addDateRangeFilter(filter, "recorded_date", req.RecordedDate, req.RecordedDateFrom, req.RecordedDateTo); err != nil {
+		return nil, err
+	}
+	if err := addPayloadFilters(filter, req.DataValueFilters); err != nil {
+		return nil, err
 	}
 
-	// Find the documents based on the filter
-	cursor, err := r.db.Collection("lifestyle_data").Find(ctx, filter)
+	page, err := newPageRequest(req.PageSize, req.PageToken, req.OrderBy)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list lifestyle data: %w", err)
+		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	var lifestyleDataRecords []*health.LifestyleData
+	// Find the documents of the requested page
+	docs, nextPageToken, totalSize, err := findPage(ctx, r.db.Collection("lifestyle_data"), filter, page)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list lifestyle data")
+	}
 
-	// Iterate through the cursor and convert each document to a proto message
-	for cursor.Next(ctx) {
-		var bsonData bson.M
-		if err := cursor.Decode(&bsonData); err != nil {
-			return nil, fmt.Errorf("failed to decode lifestyle data: %w", err)
-		}
+	response := &health.ListLifestyleDataResponse{
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}
 
+	// Convert each document to a proto message
+	for _, bsonData := range docs {
 		dataModel, err := bsonToLifestyleData(bsonData)
 		if err != nil {
 			return nil, err
 		}
 
-		lifestyleDataRecords = append(lifestyleDataRecords, dataModel)
+		response.LifestyleData = append(response.LifestyleData, dataModel)
 	}
 
-	return lifestyleDataRecords, nil
+	return response, nil
 }
 
 // bsonToLifestyleData converts a BSON document to a health.LifestyleData proto message.
@@ -218,18 +245,14 @@ func bsonToLifestyleData(bsonData bson.M) (*health.LifestyleData, error) {
 	if val, ok := bsonData["data_type"].(string); ok {
 		dataModel.DataType = val
 	}
-	if val, ok := bsonData["recorded_date"].(string); ok {
-		dataModel.RecordedDate = val
-	}
+	dataModel.RecordedDate = formatDate(bsonData["recorded_date"])
 
-	// Convert data_value from string to Any proto message
-	if val, ok := bsonData["data_value"].(string); ok {
-		dataModel.DataValue = &anypb.Any{}
-		err := protojson.Unmarshal([]byte(val), dataModel.DataValue)
-		if err != nil {
-			return nil, fmt.Errorf("failed to unmarshal data_value from JSON: %w", err)
-		}
+	// Convert data_value from its stored form to Any proto message
+	dataValue, err := decodeDataValue(bsonData["data_value"])
+	if err != nil {
+		return nil, err
 	}
+	dataModel.DataValue = dataValue
 
 	// Convert created_at and updated_at fields
 	if val, ok := bsonData["created_at"].(primitive.DateTime); ok {
@@ -238,6 +261,7 @@ func bsonToLifestyleData(bsonData bson.M) (*health.LifestyleData, error) {
 	if val, ok := bsonData["updated_at"].(primitive.DateTime); ok {
 		dataModel.UpdatedAt = val.Time().Format(time.RFC3339)
 	}
+	dataModel.Version = documentVersion(bsonData)
 
 	return dataModel, nil
 }
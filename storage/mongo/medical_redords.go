@@ -5,28 +5,33 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/health-analytics-service/health-analytics-service/encryption"
+	"github.com/health-analytics-service/health-analytics-service/errs"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // MedicalRecordRepo implements the storage.MedicalRecordRepoI interface for MongoDB.
+// Descriptions and attachments are stored encrypted with the data keys of the user.
 type MedicalRecordRepo struct {
-	db *mongo.Database
+	db     *mongo.Database
+	cipher *encryption.Cipher
 }
 
 // NewMedicalRecordRepo creates a new MedicalRecordRepo instance.
-func NewMedicalRecordRepo(db *mongo.Database) *MedicalRecordRepo {
+func NewMedicalRecordRepo(db *mongo.Database, cipher *encryption.Cipher) *MedicalRecordRepo {
 	return &MedicalRecordRepo{
-		db: db,
+		db:     db,
+		cipher: cipher,
 	}
 }
 
 // CreateMedicalRecord creates a new medical record in the database.
-func (r *MedicalRecordRepo) CreateMedicalRecord(ctx context.Context, record *health.MedicalRecord) (string, error) {
+func (r *MedicalRecordRepo) CreateMedicalRecord(ctx context.Context, record *health.MedicalRecord) (*health.MedicalRecord, error) {
 	var (
 		objectID primitive.ObjectID
 		err      error
@@ -35,37 +40,47 @@ func (r *MedicalRecordRepo) CreateMedicalRecord(ctx context.Context, record *hea
 	if record.Id != "" {
 		objectID, err = primitive.ObjectIDFromHex(record.Id)
 		if err != nil {
-			return "", err
+			return nil, errs.InvalidArgument("id", "invalid medical record ID: %v", err)
 		}
 	} else {
 		objectID = primitive.NewObjectID()
 	}
 
+	recordDate, err := dateValue("record_date", record.RecordDate)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert the model to a BSON document
 	bsonRecord := bson.M{
 		"_id":         objectID,
 		"user_id":     record.UserId,
 		"record_type": record.RecordType,
-		"record_date": record.RecordDate,
-		"description": record.Description,
+		"record_date": recordDate,
 		"doctor_id":   record.DoctorId,
-		"attachments": record.Attachments,
 		"created_at":  time.Now(),
 		"updated_at":  time.Now(),
+		versionField:  int64(1),
+	}
+	if err := encryptMedicalRecordFields(ctx, r.cipher, record.UserId, record.Description, record.Attachments, bsonRecord); err != nil {
+		return nil, err
 	}
 
 	// Insert the document into the collection
 	result, err := r.db.Collection("medical_records").InsertOne(ctx, bsonRecord)
 	if err != nil {
-		return "", fmt.Errorf("failed to create medical record: %w", err)
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, errs.AlreadyExists("medical record", objectID.Hex())
+		}
+		return nil, errs.Wrap(err, "failed to create medical record")
 	}
 
 	// Get the inserted ID as a string
 	insertedID, ok := result.InsertedID.(primitive.ObjectID)
 	if !ok {
-		return "", fmt.Errorf("failed to convert inserted ID to string")
+		return nil, fmt.Errorf("failed to convert inserted ID to string")
 	}
-	return insertedID.Hex(), nil
+	return r.GetMedicalRecord(ctx, insertedID.Hex())
 }
 
 // GetMedicalRecord retrieves a medical record by its ID.
@@ -73,21 +88,21 @@ func (r *MedicalRecordRepo) GetMedicalRecord(ctx context.Context, id string) (*h
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid medical record ID: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid medical record ID: %v", err)
 	}
 
 	// Find the document by ID
 	var bsonRecord bson.M
-	err = r.db.Collection("medical_records").FindOne(ctx, bson.M{"_id": objID}).Decode(&bsonRecord)
+	err = r.db.Collection("medical_records").FindOne(ctx, byID(ctx, objID)).Decode(&bsonRecord)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, status.Errorf(codes.NotFound, "medical record not found")
+			return nil, errs.NotFound("medical record", objID.Hex())
 		}
-		return nil, fmt.Errorf("failed to get medical record by ID: %w", err)
+		return nil, errs.Wrap(err, "failed to get medical record by ID")
 	}
 
 	// Convert the BSON document to a proto message
-	recordModel, err := bsonToMedicalRecord(bsonRecord)
+	recordModel, err := r.toMedicalRecord(ctx, bsonRecord)
 	if err != nil {
 		return nil, err
 	}
@@ -95,117 +110,285 @@ func (r *MedicalRecordRepo) GetMedicalRecord(ctx context.Context, id string) (*h
 	return recordModel, nil
 }
 
-// UpdateMedicalRecord updates an existing medical record in the database.
-func (r *MedicalRecordRepo) UpdateMedicalRecord(ctx context.Context, record *health.MedicalRecord) error {
+// UpdateMedicalRecord updates an existing medical record in the database. The previous
+// revision is archived to the medical_records_history collection and the version is
+// incremented. When record.ExpectedVersion is set, the update fails with
+// FailedPrecondition unless the record is still at that version. With an update_mask,
+// only the masked fields are written, empty or not; otherwise only non-empty fields are.
+func (r *MedicalRecordRepo) UpdateMedicalRecord(ctx context.Context, record *health.MedicalRecord) (*health.MedicalRecord, error) {
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(record.Id)
 	if err != nil {
-		return fmt.Errorf("invalid medical record ID: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid medical record ID: %v", err)
 	}
 
-	// Build the update document based on the provided fields
-	bsonRecord := bson.M{
-		"updated_at": time.Now(),
+	// Without an update_mask, the fields that are set are written
+	written := func(field string, set bool) bool {
+		if len(record.UpdateMask.GetPaths()) == 0 {
+			return set
+		}
+		return storage.MaskIncludes(record.UpdateMask, field)
+	}
+
+	var recordDate interface{}
+	if written("record_date", record.RecordDate != "") {
+		if recordDate, err = dateValue("record_date", record.RecordDate); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := updateArchived(ctx, r.db.Collection("medical_records"), "medical record", objID, record.ExpectedVersion,
+		func(current bson.M, version int64) (bson.M, error) {
+			// Build the update document based on the provided fields
+			bsonRecord := bson.M{
+				"updated_at": time.Now(),
+			}
+			if written("user_id", record.UserId != "") {
+				bsonRecord["user_id"] = record.UserId
+			}
+			if written("record_type", record.RecordType != "") {
+				bsonRecord["record_type"] = record.RecordType
+			}
+			if written("record_date", record.RecordDate != "") {
+				bsonRecord["record_date"] = recordDate
+			}
+			if written("doctor_id", record.DoctorId != "") {
+				bsonRecord["doctor_id"] = record.DoctorId
+			}
+			writeDescription := written("description", record.Description != "")
+			writeAttachments := written("attachments", len(record.Attachments) > 0)
+			if writeDescription || writeAttachments {
+				// Encrypt with the keys of the owner
+				owner, ok := bsonRecord["user_id"].(string)
+				if !ok {
+					owner, _ = current["user_id"].(string)
+				}
+				if writeDescription {
+					encrypted, err := r.cipher.Encrypt(ctx, owner, descriptionField, record.Description)
+					if err != nil {
+						return nil, errs.Wrap(err, "failed to encrypt description")
+					}
+					bsonRecord[descriptionField] = encrypted
+					bsonRecord[descriptionIndexField] = r.cipher.BlindIndex(descriptionField, record.Description)
+				}
+				if writeAttachments {
+					if err := encryptAttachments(ctx, r.cipher, owner, record.Attachments, bsonRecord); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return bson.M{"$set": bsonRecord}, nil
+		},
+		func(current bson.M, version int64) error {
+			return r.archive(ctx, current, version)
+		})
+	if err != nil {
+		return nil, err
 	}
 
-	if record.UserId != "" {
-		bsonRecord["user_id"] = record.UserId
+	return r.toMedicalRecord(ctx, updated)
+}
+
+// archive copies the current revision of a medical record to the history before it
+// is updated. Archiving the same revision twice keeps the first copy.
+func (r *MedicalRecordRepo) archive(ctx context.Context, current bson.M, version int64) error {
+	revision := bson.M{
+		"archived_at": time.Now(),
+		"archived_by": storage.ActorFromContext(ctx),
+	}
+	for key, value := range current {
+		switch key {
+		case "_id", versionField, deletedAtField, deletedByField:
+		default:
+			revision[key] = value
+		}
 	}
-	if record.RecordType != "" {
-		bsonRecord["record_type"] = record.RecordType
+
+	_, err := r.db.Collection("medical_records_history").UpdateOne(ctx,
+		bson.M{"record_id": current["_id"], versionField: version},
+		bson.M{"$setOnInsert": revision},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		return errs.Wrap(err, "failed to archive medical record")
 	}
-	if record.RecordDate != "" {
-		bsonRecord["record_date"] = record.RecordDate
+	return nil
+}
+
+// ListMedicalRecordVersions retrieves the prior revisions of a medical record, newest first.
+func (r *MedicalRecordRepo) ListMedicalRecordVersions(ctx context.Context, req *health.ListMedicalRecordVersionsRequest) (*health.ListMedicalRecordVersionsResponse, error) {
+	// The history of deleted records is hidden along with them
+	current, err := r.GetMedicalRecord(ctx, req.Id)
+	if err != nil {
+		return nil, err
 	}
-	if record.Description != "" {
-		bsonRecord["description"] = record.Description
+	objID, _ := primitive.ObjectIDFromHex(req.Id)
+
+	page, err := newPageRequest(req.PageSize, "", "")
+	if err != nil {
+		return nil, err
 	}
-	if record.DoctorId != "" {
-		bsonRecord["doctor_id"] = record.DoctorId
+	// Revisions archived by updates that did not apply are at the current version
+	filter := bson.M{"record_id": objID, versionField: bson.M{"$lt": current.Version}}
+	history := r.db.Collection("medical_records_history")
+	totalSize, err := history.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to count medical record versions")
 	}
-	if len(record.Attachments) > 0 {
-		bsonRecord["attachments"] = record.Attachments
+	if req.PageToken != "" {
+		before, err := storage.ParseVersionPageToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		filter[versionField] = bson.M{"$lt": min(before, current.Version)}
 	}
 
-	// Update the document in the collection
-	result, err := r.db.Collection("medical_records").UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": bsonRecord})
+	// Fetch one extra revision to find out whether there is a next page
+	cursor, err := history.Find(ctx, filter,
+		options.Find().SetSort(bson.D{{Key: versionField, Value: -1}}).SetLimit(page.size+1))
 	if err != nil {
-		return fmt.Errorf("failed to update medical record: %w", err)
+		return nil, errs.Wrap(err, "failed to list medical record versions")
 	}
+	defer cursor.Close(ctx)
 
-	if result.ModifiedCount == 0 {
-		return status.Errorf(codes.NotFound, "medical record not found")
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, errs.Wrap(err, "failed to decode medical record versions")
 	}
 
-	return nil
+	response := &health.ListMedicalRecordVersionsResponse{TotalSize: totalSize}
+	if int64(len(docs)) > page.size {
+		docs = docs[:page.size]
+		response.NextPageToken = storage.VersionPageToken(documentVersion(docs[len(docs)-1]))
+	}
+	for _, doc := range docs {
+		revision, err := r.toMedicalRecordVersion(ctx, doc)
+		if err != nil {
+			return nil, err
+		}
+		response.Versions = append(response.Versions, revision)
+	}
+
+	return response, nil
 }
 
-// DeleteMedicalRecord deletes a medical record from the database.
+// GetMedicalRecordVersion retrieves a revision of a medical record, either the
+// current one or a prior one from the history.
+func (r *MedicalRecordRepo) GetMedicalRecordVersion(ctx context.Context, id string, version int64) (*health.MedicalRecord, error) {
+	if version < 1 {
+		return nil, errs.InvalidArgument("version", "version must be positive")
+	}
+	current, err := r.GetMedicalRecord(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if version == current.Version {
+		return current, nil
+	}
+	if version > current.Version {
+		return nil, errs.NotFound("medical record version", fmt.Sprintf("%s@%d", id, version))
+	}
+
+	objID, _ := primitive.ObjectIDFromHex(id)
+	var doc bson.M
+	err = r.db.Collection("medical_records_history").FindOne(ctx, bson.M{"record_id": objID, versionField: version}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errs.NotFound("medical record version", fmt.Sprintf("%s@%d", id, version))
+		}
+		return nil, errs.Wrap(err, "failed to get medical record version")
+	}
+
+	return r.toMedicalRecordVersion(ctx, doc)
+}
+
+// DeleteMedicalRecord soft-deletes a medical record. It is excluded from all reads and can be
+// restored until it is purged by the retention job.
 func (r *MedicalRecordRepo) DeleteMedicalRecord(ctx context.Context, id string) error {
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return fmt.Errorf("invalid medical record ID: %w", err)
+		return errs.InvalidArgument("id", "invalid medical record ID: %v", err)
 	}
 
-	// Delete the document from the collection
-	result, err := r.db.Collection("medical_records").DeleteOne(ctx, bson.M{"_id": objID})
+	return softDelete(ctx, r.db.Collection("medical_records"), "medical record", objID)
+}
+
+// RestoreMedicalRecord restores a medical record that was soft-deleted and not purged yet.
+func (r *MedicalRecordRepo) RestoreMedicalRecord(ctx context.Context, id string) (*health.MedicalRecord, error) {
+	// Convert the string ID to an ObjectID
+	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return fmt.Errorf("failed to delete medical record: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid medical record ID: %v", err)
 	}
 
-	if result.DeletedCount == 0 {
-		return status.Errorf(codes.NotFound, "medical record not found")
+	restored, err := restore(ctx, r.db.Collection("medical_records"), "medical record", objID)
+	if err != nil {
+		return nil, err
 	}
-
-	return nil
+	return r.toMedicalRecord(ctx, restored)
 }
 
 // ListMedicalRecords retrieves all medical records for a given user ID, applying filters if provided.
-func (r *MedicalRecordRepo) ListMedicalRecords(ctx context.Context, req *health.ListMedicalRecordsRequest) ([]*health.MedicalRecord, error) {
+func (r *MedicalRecordRepo) ListMedicalRecords(ctx context.Context, req *health.ListMedicalRecordsRequest) (*health.ListMedicalRecordsResponse, error) {
 	// Build the filter query based on the request parameters
-	filter := bson.M{}
+	filter := notDeleted(bson.M{})
 	if req.UserId != "" {
 		filter["user_id"] = req.UserId
 	}
 	if req.RecordType != "" {
 		filter["record_type"] = req.RecordType
 	}
-	if req.RecordDate != "" {
-		filter["record_date"] = req.RecordDate
+	if err := addDateRangeFilter(filter, "record_date", req.RecordDate, req.RecordDateFrom, req.RecordDateTo); err != nil {
+		return nil, err
 	}
 	if req.Description != "" {
-		filter["description"] = req.Description
+		addDescriptionFilter(filter, r.cipher, req.Description)
 	}
 	if req.DoctorId != "" {
 		filter["doctor_id"] = req.DoctorId
 	}
 
-	// Find the documents based on the filter
-	cursor, err := r.db.Collection("medical_records").Find(ctx, filter)
+	page, err := newPageRequest(req.PageSize, req.PageToken, req.OrderBy)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list medical records: %w", err)
+		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	var medicalRecords []*health.MedicalRecord
+	// Find the documents of the requested page
+	docs, nextPageToken, totalSize, err := findPage(ctx, r.db.Collection("medical_records"), filter, page)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list medical records")
+	}
 
-	// Iterate through the cursor and convert each document to a proto message
-	for cursor.Next(ctx) {
-		var bsonRecord bson.M
-		if err := cursor.Decode(&bsonRecord); err != nil {
-			return nil, fmt.Errorf("failed to decode medical record: %w", err)
-		}
+	response := &health.ListMedicalRecordsResponse{
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}
 
-		recordModel, err := bsonToMedicalRecord(bsonRecord)
+	// Convert each document to a proto message
+	for _, bsonRecord := range docs {
+		recordModel, err := r.toMedicalRecord(ctx, bsonRecord)
 		if err != nil {
 			return nil, err
 		}
 
-		medicalRecords = append(medicalRecords, recordModel)
+		response.MedicalRecords = append(response.MedicalRecords, recordModel)
+	}
+
+	return response, nil
+}
+
+// toMedicalRecord decrypts a stored medical record and converts it to a proto message.
+func (r *MedicalRecordRepo) toMedicalRecord(ctx context.Context, bsonRecord bson.M) (*health.MedicalRecord, error) {
+	if err := decryptMedicalRecordFields(ctx, r.cipher, bsonRecord); err != nil {
+		return nil, err
 	}
+	return bsonToMedicalRecord(bsonRecord)
+}
 
-	return medicalRecords, nil
+// toMedicalRecordVersion converts an archived revision to a proto message.
+func (r *MedicalRecordRepo) toMedicalRecordVersion(ctx context.Context, revision bson.M) (*health.MedicalRecord, error) {
+	revision["_id"] = revision["record_id"]
+	return r.toMedicalRecord(ctx, revision)
 }
 
 // bsonToMedicalRecord converts a BSON document to a health.MedicalRecord proto message.
@@ -228,9 +411,7 @@ func bsonToMedicalRecord(bsonRecord bson.M) (*health.MedicalRecord, error) {
 	if val, ok := bsonRecord["record_type"].(string); ok {
 		recordModel.RecordType = val
 	}
-	if val, ok := bsonRecord["record_date"].(string); ok {
-		recordModel.RecordDate = val
-	}
+	recordModel.RecordDate = formatDate(bsonRecord["record_date"])
 	if val, ok := bsonRecord["description"].(string); ok {
 		recordModel.Description = val
 	}
@@ -252,6 +433,7 @@ func bsonToMedicalRecord(bsonRecord bson.M) (*health.MedicalRecord, error) {
 	if val, ok := bsonRecord["updated_at"].(primitive.DateTime); ok {
 		recordModel.UpdatedAt = val.Time().Format(time.RFC3339)
 	}
+	recordModel.Version = documentVersion(bsonRecord)
 
 	return recordModel, nil
 }
@@ -0,0 +1,406 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+)
+
+// Migration is a versioned change of the stored data. Migrations are applied in
+// ascending version order and reverted in descending order.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      func(ctx context.Context, db *mongo.Database) error
+	Down    func(ctx context.Context, db *mongo.Database) error // nil when irreversible
+}
+
+// migrations lists the registered migrations. Versions are never reused: add new
+// migrations at the end with the next version.
+var migrations = []Migration{
+	{Version: 1, Name: "convert_string_dates", Up: migrateStringDates, Down: revertStringDates},
+	{Version: 2, Name: "convert_payload_strings", Up: migratePayloadStrings, Down: revertPayloadStrings},
+	{Version: 3, Name: "store_zero_payload_fields", Up: migrateZeroPayloadFields, Down: revertZeroPayloadFields},
+}
+
+// Migrations returns the registered migrations in version order.
+func Migrations() []Migration {
+	return append([]Migration(nil), migrations...)
+}
+
+const (
+	// migrationsCollection holds one document per applied migration, keyed by version.
+	migrationsCollection = "schema_migrations"
+	// migrationLockCollection holds the lock taken while migrations are applied.
+	migrationLockCollection = "schema_migrations_lock"
+	// migrationLockLease is how long a lock is honored; a lock left behind by a
+	// crashed run is taken over once it expires.
+	migrationLockLease = 15 * time.Minute
+	// migrationLockRenewal is how often a run renews its lease while it is running.
+	migrationLockRenewal = migrationLockLease / 3
+	// migrationLockPoll is how often startup checks whether the migrations run by
+	// another process are done.
+	migrationLockPoll = 5 * time.Second
+)
+
+// appliedMigration is the stored form of an applied migration.
+type appliedMigration struct {
+	Version   int64     `bson:"_id"`
+	Name      string    `bson:"name"`
+	AppliedAt time.Time `bson:"applied_at"`
+}
+
+// MigrationStatus describes a migration that is registered, applied, or both.
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	AppliedAt time.Time // Zero while pending
+	Unknown   bool      // Applied but not registered, e.g. by a newer version of the service
+}
+
+// MigrateOptions selects the migrations applied or reverted by a run.
+type MigrateOptions struct {
+	// To bounds the versions: up applies pending migrations up to and including it
+	// (0 for all), down reverts applied migrations above it.
+	To int64
+	// Steps limits the number of migrations applied or reverted; 0 for no limit.
+	Steps int
+	// DryRun only reports the migrations that would be applied or reverted.
+	DryRun bool
+}
+
+// Migrator applies and reverts migrations, recording the applied ones in the
+// schema_migrations collection.
+type Migrator struct {
+	db         *mongo.Database
+	migrations []Migration
+}
+
+// NewMigrator creates a Migrator of the given migrations, which must have distinct
+// positive versions.
+func NewMigrator(db *mongo.Database, migrations []Migration) (*Migrator, error) {
+	sorted := append([]Migration(nil), migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+	for i, migration := range sorted {
+		if migration.Version <= 0 || migration.Up == nil {
+			return nil, fmt.Errorf("invalid migration %d %s", migration.Version, migration.Name)
+		}
+		if i > 0 && sorted[i-1].Version == migration.Version {
+			return nil, fmt.Errorf("duplicate migration version %d", migration.Version)
+		}
+	}
+	return &Migrator{
+		db:         db,
+		migrations: sorted,
+	}, nil
+}
+
+// Status lists the registered and applied migrations in version order.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []MigrationStatus
+	for _, migration := range m.migrations {
+		status := MigrationStatus{Version: migration.Version, Name: migration.Name}
+		if a, ok := applied[migration.Version]; ok {
+			status.AppliedAt = a.AppliedAt
+			delete(applied, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+	for _, a := range applied {
+		statuses = append(statuses, MigrationStatus{Version: a.Version, Name: a.Name, AppliedAt: a.AppliedAt, Unknown: true})
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+	return statuses, nil
+}
+
+// Up applies the pending migrations selected by opts in ascending version order and
+// returns them. On error, the migrations applied before the failing one are returned
+// along with it.
+func (m *Migrator) Up(ctx context.Context, opts MigrateOptions) ([]Migration, error) {
+	return m.run(ctx, opts.DryRun, func() ([]Migration, error) {
+		return m.planUp(ctx, opts)
+	}, func(ctx context.Context, lock *migrationLock, migration Migration) error {
+		if err := migration.Up(ctx, m.db); err != nil {
+			return errs.Wrap(err, "migration %d %s failed", migration.Version, migration.Name)
+		}
+		if err := lock.renew(ctx); err != nil {
+			return err
+		}
+		_, err := m.db.Collection(migrationsCollection).InsertOne(ctx, appliedMigration{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			AppliedAt: time.Now(),
+		})
+		if err != nil {
+			return errs.Wrap(err, "failed to record migration %d %s", migration.Version, migration.Name)
+		}
+		return nil
+	})
+}
+
+// Down reverts the applied migrations selected by opts in descending version order
+// and returns them. Reverting an irreversible or unknown migration fails before
+// anything is reverted.
+func (m *Migrator) Down(ctx context.Context, opts MigrateOptions) ([]Migration, error) {
+	return m.run(ctx, opts.DryRun, func() ([]Migration, error) {
+		return m.planDown(ctx, opts)
+	}, func(ctx context.Context, lock *migrationLock, migration Migration) error {
+		if err := migration.Down(ctx, m.db); err != nil {
+			return errs.Wrap(err, "reverting migration %d %s failed", migration.Version, migration.Name)
+		}
+		if err := lock.renew(ctx); err != nil {
+			return err
+		}
+		_, err := m.db.Collection(migrationsCollection).DeleteOne(ctx, bson.M{"_id": migration.Version})
+		if err != nil {
+			return errs.Wrap(err, "failed to record reverting migration %d %s", migration.Version, migration.Name)
+		}
+		return nil
+	})
+}
+
+// planUp selects the pending migrations applied by Up.
+func (m *Migrator) planUp(ctx context.Context, opts MigrateOptions) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+		if opts.To > 0 && migration.Version > opts.To {
+			break
+		}
+		if opts.Steps > 0 && len(pending) == opts.Steps {
+			break
+		}
+		pending = append(pending, migration)
+	}
+	return pending, nil
+}
+
+// planDown selects the applied migrations reverted by Down.
+func (m *Migrator) planDown(ctx context.Context, opts MigrateOptions) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	registered := make(map[int64]Migration)
+	for _, migration := range m.migrations {
+		registered[migration.Version] = migration
+	}
+
+	versions := make([]int64, 0, len(applied))
+	for version := range applied {
+		if version > opts.To {
+			versions = append(versions, version)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+	if opts.Steps > 0 && len(versions) > opts.Steps {
+		versions = versions[:opts.Steps]
+	}
+
+	var reverting []Migration
+	for _, version := range versions {
+		migration, ok := registered[version]
+		if !ok {
+			return nil, errs.FailedPrecondition(fmt.Sprintf("migration/%d", version),
+				"migration %d %s is not registered", version, applied[version].Name)
+		}
+		if migration.Down == nil {
+			return nil, errs.FailedPrecondition(fmt.Sprintf("migration/%d", version),
+				"migration %d %s is irreversible", version, migration.Name)
+		}
+		reverting = append(reverting, migration)
+	}
+	return reverting, nil
+}
+
+// run selects migrations with plan and performs step for each of them in order. The
+// selection is made while holding the migration lock, except for dry runs which only
+// return it. The lock is renewed while the steps run, and they are canceled if it is
+// lost; steps also renew it before recording their migration.
+func (m *Migrator) run(ctx context.Context, dryRun bool, plan func() ([]Migration, error),
+	step func(context.Context, *migrationLock, Migration) error) ([]Migration, error) {
+	if dryRun {
+		return plan()
+	}
+
+	lock, err := m.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.release()
+	ctx, stop := lock.keepAlive(ctx)
+	defer stop()
+
+	selected, err := plan()
+	if err != nil {
+		return nil, err
+	}
+	for i, migration := range selected {
+		if err := step(ctx, lock, migration); err != nil {
+			if lock.lost() {
+				err = errs.Aborted("MIGRATION_LOCK_LOST", "the migration lock was taken over while running migration %d %s", migration.Version, migration.Name)
+			}
+			return selected[:i], err
+		}
+	}
+	return selected, nil
+}
+
+// applyMigrations applies all pending registered migrations and logs them. When
+// another process holds the migration lock, e.g. a replica starting at the same time,
+// it waits for that process to finish and applies what is still pending, so that the
+// service never starts on unmigrated data.
+func applyMigrations(ctx context.Context, db *mongo.Database) error {
+	migrator, err := NewMigrator(db, migrations)
+	if err != nil {
+		return err
+	}
+	for {
+		applied, err := migrator.Up(ctx, MigrateOptions{})
+		for _, migration := range applied {
+			slog.Info(fmt.Sprintf("applied migration %d %s", migration.Version, migration.Name))
+		}
+		if errs.Code(err) != codes.Aborted {
+			return err
+		}
+
+		slog.Info("Waiting for migrations run by another process:" + err.Error())
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(migrationLockPoll):
+		}
+	}
+}
+
+// applied returns the applied migrations by version.
+func (m *Migrator) applied(ctx context.Context) (map[int64]appliedMigration, error) {
+	cursor, err := m.db.Collection(migrationsCollection).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list applied migrations")
+	}
+	var docs []appliedMigration
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, errs.Wrap(err, "failed to decode applied migrations")
+	}
+
+	applied := make(map[int64]appliedMigration, len(docs))
+	for _, doc := range docs {
+		applied[doc.Version] = doc
+	}
+	return applied, nil
+}
+
+// migrationLock is the migration lock held by a run, identified by its owner.
+type migrationLock struct {
+	collection *mongo.Collection
+	owner      string
+	canceled   chan struct{} // Closed once the lock is lost
+	cancel     sync.Once
+}
+
+// lock takes the migration lock so that concurrent runs, e.g. by replicas starting
+// together, do not apply the same migrations twice.
+func (m *Migrator) lock(ctx context.Context) (*migrationLock, error) {
+	lock := &migrationLock{
+		collection: m.db.Collection(migrationLockCollection),
+		owner:      primitive.NewObjectID().Hex(),
+		canceled:   make(chan struct{}),
+	}
+	now := time.Now()
+
+	// Take the lock unless it is held and has not expired; a held lock makes the
+	// upsert insert a duplicate _id
+	_, err := lock.collection.UpdateOne(ctx,
+		bson.M{"_id": "lock", "locked_at": bson.M{"$lt": now.Add(-migrationLockLease)}},
+		bson.M{"$set": bson.M{"owner": lock.owner, "locked_at": now}},
+		options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, errs.Aborted("MIGRATION_LOCKED", "migrations are being run by another process")
+	}
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to lock migrations")
+	}
+	return lock, nil
+}
+
+// renew extends the lease of the lock, failing with Aborted if it was taken over.
+func (l *migrationLock) renew(ctx context.Context) error {
+	result, err := l.collection.UpdateOne(ctx,
+		bson.M{"_id": "lock", "owner": l.owner},
+		bson.M{"$set": bson.M{"locked_at": time.Now()}})
+	if err != nil {
+		return errs.Wrap(err, "failed to renew the migration lock")
+	}
+	if result.MatchedCount == 0 {
+		l.cancel.Do(func() { close(l.canceled) })
+		return errs.Aborted("MIGRATION_LOCK_LOST", "the migration lock was taken over by another process")
+	}
+	return nil
+}
+
+// lost reports whether the lock was found taken over.
+func (l *migrationLock) lost() bool {
+	select {
+	case <-l.canceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// keepAlive renews the lock every migrationLockRenewal until stopped. The returned
+// context is canceled when the lock is lost, so that a run taken over stops.
+func (l *migrationLock) keepAlive(ctx context.Context) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(migrationLockRenewal)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-l.canceled:
+				cancel()
+				return
+			case <-ticker.C:
+				if err := l.renew(ctx); err != nil && !l.lost() {
+					slog.Warn("Unable to renew the migration lock:" + err.Error())
+				}
+			}
+		}
+	}()
+	return ctx, func() {
+		cancel()
+		<-done
+	}
+}
+
+// release releases the lock unless it was taken over.
+func (l *migrationLock) release() {
+	l.collection.DeleteOne(context.Background(), bson.M{"_id": "lock", "owner": l.owner})
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 
 	"github.com/health-analytics-service/health-analytics-service/config"
+	"github.com/health-analytics-service/health-analytics-service/encryption"
 	"github.com/health-analytics-service/health-analytics-service/storage"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -20,10 +21,60 @@ type StorageM struct {
 	wearableDataRepo         storage.WearableDataRepoI
 	healthRecommendationRepo storage.HealthRecommendationRepoI
 	healthMonitoringRepo     storage.HealthMonitoringRepoI
+	auditLogRepo             storage.AuditLogRepoI
+	retentionRepo            storage.RetentionRepoI
 }
 
 // NewMongoStorage creates a new MongoDB storage instance.
 func NewMongoStorage(cfg config.Config) (storage.StorageI, error) {
+	db, err := Connect(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the pending data migrations, such as converting the dates stored as
+	// strings by earlier versions
+	if err := applyMigrations(context.Background(), db); err != nil {
+		slog.Warn("Unable to apply migrations:" + err.Error())
+		return nil, err
+	}
+
+	// Create the indexes of the list and summary queries
+	report, err := EnsureIndexes(context.Background(), db)
+	if err != nil {
+		slog.Warn("Unable to create indexes:" + err.Error())
+		return nil, err
+	}
+	logIndexReport(report)
+
+	// Sensitive fields are encrypted with per-user data keys
+	cipher, err := encryption.NewCipherFromConfig(cfg, NewDataKeyStore(db))
+	if err != nil {
+		slog.Warn("Unable to configure field encryption:" + err.Error())
+		return nil, err
+	}
+
+	return NewStorage(db, cipher), nil
+}
+
+// NewStorage creates the MongoDB storage of a connected database whose sensitive
+// fields are encrypted with cipher.
+func NewStorage(db *mongo.Database, cipher *encryption.Cipher) storage.StorageI {
+	return &StorageM{
+		db:                       db,
+		medicalRecordRepo:        NewMedicalRecordRepo(db, cipher),
+		geneticDataRepo:          NewGeneticDataRepo(db, cipher),
+		lifestyleDataRepo:        NewLifestyleDataRepo(db),
+		wearableDataRepo:         NewWearableDataRepo(db),
+		healthRecommendationRepo: NewHealthRecommendationRepo(db),
+		healthMonitoringRepo:     NewHealthMonitoringRepo(db, cipher),
+		auditLogRepo:             NewAuditLogRepo(db),
+		retentionRepo:            NewRetentionRepo(db),
+	}
+}
+
+// Connect connects to the configured MongoDB database.
+func Connect(cfg config.Config) (*mongo.Database, error) {
 	// Construct MongoDB connection URI
 	uri := fmt.Sprintf("mongodb://%s:%d",
 		cfg.MongoHost,
@@ -45,17 +96,7 @@ func NewMongoStorage(cfg config.Config) (storage.StorageI, error) {
 		return nil, err
 	}
 
-	db := client.Database(cfg.MongoDB)
-
-	return &StorageM{
-		db:                       db,
-		medicalRecordRepo:        NewMedicalRecordRepo(db),
-		geneticDataRepo:          NewGeneticDataRepo(db),
-		lifestyleDataRepo:        NewLifestyleDataRepo(db),
-		wearableDataRepo:         NewWearableDataRepo(db),
-		healthRecommendationRepo: NewHealthRecommendationRepo(db),
-		healthMonitoringRepo:     NewHealthMonitoringRepo(db),
-	}, nil
+	return client.Database(cfg.MongoDB), nil
 }
 
 // MedicalRecord returns the MedicalRecordRepoI implementation for MongoDB.
@@ -87,3 +128,13 @@ func (s *StorageM) HealthRecommendation() storage.HealthRecommendationRepoI {
 func (s *StorageM) HealthMonitoring() storage.HealthMonitoringRepoI {
 	return s.healthMonitoringRepo
 }
+
+// AuditLog returns the AuditLogRepoI implementation for MongoDB.
+func (s *StorageM) AuditLog() storage.AuditLogRepoI {
+	return s.auditLogRepo
+}
+
+// Retention returns the RetentionRepoI implementation for MongoDB.
+func (s *StorageM) Retention() storage.RetentionRepoI {
+	return s.retentionRepo
+}
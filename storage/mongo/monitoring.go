@@ -2,148 +2,351 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
 	"time"
 
+	"github.com/health-analytics-service/health-analytics-service/encryption"
+	"github.com/health-analytics-service/health-analytics-service/errs"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // HealthMonitoringRepo implements the storage.HealthMonitoringRepoI interface for MongoDB.
 type HealthMonitoringRepo struct {
-	db *mongo.Database
+	db     *mongo.Database
+	cipher *encryption.Cipher // Decrypts the medical records and genetic data of summaries
 }
 
 // NewHealthMonitoringRepo creates a new HealthMonitoringRepo instance.
-func NewHealthMonitoringRepo(db *mongo.Database) *HealthMonitoringRepo {
+func NewHealthMonitoringRepo(db *mongo.Database, cipher *encryption.Cipher) *HealthMonitoringRepo {
 	return &HealthMonitoringRepo{
-		db: db,
+		db:     db,
+		cipher: cipher,
 	}
 }
 
 // GetDailySummary retrieves a daily summary of health data for a given user ID and date.
+// The day is interpreted as a calendar day in the requested time zone (UTC by default)
+// and defaults to the current day when no date is given.
 func (r *HealthMonitoringRepo) GetDailySummary(ctx context.Context, req *health.DailySummaryRequest) (*health.SummaryResponse, error) {
+	window, err := storage.DailySummaryWindow(req)
+	if err != nil {
+		return nil, err
+	}
+	return r.getSummary(ctx, req.UserId, window)
+}
+
+// GetWeeklySummary retrieves a weekly summary of health data for a given user ID and date range.
+// Both dates are inclusive calendar days in the requested time zone (UTC by default).
+func (r *HealthMonitoringRepo) GetWeeklySummary(ctx context.Context, req *health.WeeklySummaryRequest) (*health.SummaryResponse, error) {
+	window, err := storage.WeeklySummaryWindow(req)
+	if err != nil {
+		return nil, err
+	}
+	return r.getSummary(ctx, req.UserId, window)
+}
+
+// summaryCollections lists the collections covered by summaries along with the
+// field their documents are grouped by in type counts.
+var summaryCollections = []struct {
+	name      string
+	typeField string
+}{
+	{name: "medical_records", typeField: "record_type"},
+	{name: "genetic_data", typeField: "data_type"},
+	{name: "lifestyle_data", typeField: "data_type"},
+	{name: "wearable_data", typeField: "data_type"},
+	{name: "health_recommendations", typeField: "recommendation_type"},
+}
+
+// getSummary computes the aggregates of all health data created by the user within
+// the window, bucketing days in its location. Raw records are only loaded when
+// the window includes them.
+func (r *HealthMonitoringRepo) getSummary(ctx context.Context, userID string, window storage.SummaryWindow) (*health.SummaryResponse, error) {
+	loc := window.Location
+
 	// Build the filter query based on the request parameters
-	filter := bson.M{
-		"user_id": req.UserId,
+	filter := notDeleted(bson.M{
+		"user_id": userID,
 		"created_at": bson.M{
-			"$gte": time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), 0, 0, 0, 0, time.UTC),
-			"$lt":  time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day()+1, 0, 0, 0, 0, time.UTC),
+			"$gte": window.From,
+			"$lt":  window.To,
 		},
+	})
+
+	summaryResponse := &health.SummaryResponse{}
+	if window.Records > 0 {
+		if err := r.loadSummaryRecords(ctx, filter, window, summaryResponse); err != nil {
+			return nil, err
+		}
+	}
+
+	// Pre-populate one bucket per calendar day so that days without data are reported too
+	buckets := make(map[string]*health.DailyBucket)
+	for _, day := range window.Days() {
+		bucket := &health.DailyBucket{Date: day}
+		buckets[bucket.Date] = bucket
+		summaryResponse.DailyBuckets = append(summaryResponse.DailyBuckets, bucket)
+	}
+
+	for _, collection := range summaryCollections {
+		typeCounts, err := r.aggregateTypeCounts(ctx, collection.name, collection.typeField, filter)
+		if err != nil {
+			return nil, err
+		}
+		summaryResponse.TypeCounts = append(summaryResponse.TypeCounts, typeCounts...)
+
+		dailyCounts, err := r.aggregateDailyCounts(ctx, collection.name, filter, loc)
+		if err != nil {
+			return nil, err
+		}
+		for day, count := range dailyCounts {
+			bucket, ok := buckets[day]
+			if !ok {
+				continue
+			}
+			switch collection.name {
+			case "medical_records":
+				bucket.MedicalRecords = count
+			case "genetic_data":
+				bucket.GeneticData = count
+			case "lifestyle_data":
+				bucket.LifestyleData = count
+			case "wearable_data":
+				bucket.WearableData = count
+			case "health_recommendations":
+				bucket.HealthRecommendations = count
+			}
+		}
+	}
+
+	// Retrieve wearable metrics over the whole window
+	wearableMetrics, err := r.aggregateWearableMetrics(ctx, filter, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, metrics := range wearableMetrics {
+		summaryResponse.WearableMetrics = append(summaryResponse.WearableMetrics, metrics...)
 	}
 
+	// Retrieve wearable metrics per day
+	dailyWearableMetrics, err := r.aggregateWearableMetrics(ctx, filter, loc)
+	if err != nil {
+		return nil, err
+	}
+	for day, metrics := range dailyWearableMetrics {
+		if bucket, ok := buckets[day]; ok {
+			bucket.WearableMetrics = metrics
+		}
+	}
+
+	return summaryResponse, nil
+}
+
+// loadSummaryRecords fills the raw record lists of the summary response with the
+// oldest records of the window, up to its limit.
+func (r *HealthMonitoringRepo) loadSummaryRecords(ctx context.Context, filter bson.M, window storage.SummaryWindow, summaryResponse *health.SummaryResponse) error {
+	var err error
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(window.Records + 1)
+
 	// Retrieve medical records
-	medicalRecords, err := r.getMedicalRecordsForSummary(ctx, filter)
+	summaryResponse.MedicalRecords, err = r.getMedicalRecordsForSummary(ctx, filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve medical records: %w", err)
+		return errs.Wrap(err, "failed to retrieve medical records")
 	}
 
 	// Retrieve genetic data
-	geneticData, err := r.getGeneticDataForSummary(ctx, filter)
+	summaryResponse.GeneticData, err = r.getGeneticDataForSummary(ctx, filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve genetic data: %w", err)
+		return errs.Wrap(err, "failed to retrieve genetic data")
 	}
 
 	// Retrieve lifestyle data
-	lifestyleData, err := r.getLifestyleDataForSummary(ctx, filter)
+	summaryResponse.LifestyleData, err = r.getLifestyleDataForSummary(ctx, filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve lifestyle data: %w", err)
+		return errs.Wrap(err, "failed to retrieve lifestyle data")
 	}
 
 	// Retrieve wearable data
-	wearableData, err := r.getWearableDataForSummary(ctx, filter)
+	summaryResponse.WearableData, err = r.getWearableDataForSummary(ctx, filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve wearable data: %w", err)
+		return errs.Wrap(err, "failed to retrieve wearable data")
 	}
 
 	// Retrieve health recommendations
-	healthRecommendations, err := r.getHealthRecommendationsForSummary(ctx, filter)
+	summaryResponse.HealthRecommendations, err = r.getHealthRecommendationsForSummary(ctx, filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve health recommendations: %w", err)
+		return errs.Wrap(err, "failed to retrieve health recommendations")
 	}
 
-	// Construct the summary response
-	summaryResponse := &health.SummaryResponse{
-		MedicalRecords:        medicalRecords,
-		GeneticData:           geneticData,
-		LifestyleData:         lifestyleData,
-		WearableData:          wearableData,
-		HealthRecommendations: healthRecommendations,
-	}
+	// Keep the oldest records of each collection, loaded with one more to tell
+	// whether any were left out
+	truncated := &summaryResponse.RecordsTruncated
+	summaryResponse.MedicalRecords = storage.LimitSummaryRecords(summaryResponse.MedicalRecords, window, truncated)
+	summaryResponse.GeneticData = storage.LimitSummaryRecords(summaryResponse.GeneticData, window, truncated)
+	summaryResponse.LifestyleData = storage.LimitSummaryRecords(summaryResponse.LifestyleData, window, truncated)
+	summaryResponse.WearableData = storage.LimitSummaryRecords(summaryResponse.WearableData, window, truncated)
+	summaryResponse.HealthRecommendations = storage.LimitSummaryRecords(summaryResponse.HealthRecommendations, window, truncated)
 
-	return summaryResponse, nil
+	return nil
 }
 
-// GetWeeklySummary retrieves a weekly summary of health data for a given user ID and date range.
-func (r *HealthMonitoringRepo) GetWeeklySummary(ctx context.Context, req *health.WeeklySummaryRequest) (*health.SummaryResponse, error) {
-	// Parse start and end dates
-	startDate, err := time.Parse("2006-01-02", req.StartDate)
-	if err != nil {
-		return nil, fmt.Errorf("invalid start date: %w", err)
+// aggregateTypeCounts counts the matching documents of a collection per value of typeField.
+func (r *HealthMonitoringRepo) aggregateTypeCounts(ctx context.Context, collection, typeField string, filter bson.M) ([]*health.TypeCount, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   "$" + typeField,
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.M{"_id": 1}}},
 	}
-	endDate, err := time.Parse("2006-01-02", req.EndDate)
+
+	cursor, err := r.db.Collection(collection).Aggregate(ctx, pipeline)
 	if err != nil {
-		return nil, fmt.Errorf("invalid end date: %w", err)
+		return nil, errs.Wrap(err, "failed to aggregate %s type counts", collection)
 	}
+	defer cursor.Close(ctx)
 
-	// Build the filter query based on the request parameters
-	filter := bson.M{
-		"user_id": req.UserId,
-		"created_at": bson.M{
-			"$gte": startDate,
-			"$lt":  endDate.AddDate(0, 0, 1), // Add 1 day to include the end date
-		},
+	var rows []struct {
+		Type  string `bson:"_id"`
+		Count int64  `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, errs.Wrap(err, "failed to decode %s type counts", collection)
 	}
 
-	// Retrieve medical records
-	medicalRecords, err := r.getMedicalRecordsForSummary(ctx, filter)
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve medical records: %w", err)
+	typeCounts := make([]*health.TypeCount, 0, len(rows))
+	for _, row := range rows {
+		typeCounts = append(typeCounts, &health.TypeCount{
+			Collection: collection,
+			Type:       row.Type,
+			Count:      row.Count,
+		})
 	}
+	return typeCounts, nil
+}
 
-	// Retrieve genetic data
-	geneticData, err := r.getGeneticDataForSummary(ctx, filter)
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve genetic data: %w", err)
+// aggregateDailyCounts counts the matching documents of a collection per calendar day in loc.
+func (r *HealthMonitoringRepo) aggregateDailyCounts(ctx context.Context, collection string, filter bson.M, loc *time.Location) (map[string]int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   summaryDayExpression(loc),
+			"count": bson.M{"$sum": 1},
+		}}},
 	}
 
-	// Retrieve lifestyle data
-	lifestyleData, err := r.getLifestyleDataForSummary(ctx, filter)
+	cursor, err := r.db.Collection(collection).Aggregate(ctx, pipeline)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve lifestyle data: %w", err)
+		return nil, errs.Wrap(err, "failed to aggregate %s daily counts", collection)
 	}
+	defer cursor.Close(ctx)
 
-	// Retrieve wearable data
-	wearableData, err := r.getWearableDataForSummary(ctx, filter)
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve wearable data: %w", err)
+	var rows []struct {
+		Day   string `bson:"_id"`
+		Count int64  `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, errs.Wrap(err, "failed to decode %s daily counts", collection)
 	}
 
-	// Retrieve health recommendations
-	healthRecommendations, err := r.getHealthRecommendationsForSummary(ctx, filter)
+	dailyCounts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		dailyCounts[row.Day] = row.Count
+	}
+	return dailyCounts, nil
+}
+
+// aggregateWearableMetrics computes statistics over the numeric wearable values per data type.
+// When loc is nil the whole window is aggregated under the "" key, otherwise the
+// metrics are additionally grouped per calendar day in loc.
+// The percentiles rely on the $percentile accumulator (MongoDB 7.0+).
+func (r *HealthMonitoringRepo) aggregateWearableMetrics(ctx context.Context, filter bson.M, loc *time.Location) (map[string][]*health.MetricStats, error) {
+	match := bson.M{"value": bson.M{"$type": "number"}}
+	for key, value := range filter {
+		match[key] = value
+	}
+
+	groupID := bson.M{"data_type": "$data_type"}
+	if loc != nil {
+		groupID["day"] = summaryDayExpression(loc)
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   groupID,
+			"count": bson.M{"$sum": 1},
+			"min":   bson.M{"$min": "$value"},
+			"max":   bson.M{"$max": "$value"},
+			"mean":  bson.M{"$avg": "$value"},
+			"percentiles": bson.M{"$percentile": bson.M{
+				"input":  "$value",
+				"p":      storage.SummaryPercentiles,
+				"method": "approximate",
+			}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id.day", Value: 1}, {Key: "_id.data_type", Value: 1}}}},
+	}
+
+	cursor, err := r.db.Collection("wearable_data").Aggregate(ctx, pipeline)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve health recommendations: %w", err)
+		return nil, errs.Wrap(err, "failed to aggregate wearable metrics")
 	}
+	defer cursor.Close(ctx)
 
-	// Construct the summary response
-	summaryResponse := &health.SummaryResponse{
-		MedicalRecords:        medicalRecords,
-		GeneticData:           geneticData,
-		LifestyleData:         lifestyleData,
-		WearableData:          wearableData,
-		HealthRecommendations: healthRecommendations,
+	var rows []struct {
+		ID struct {
+			DataType string `bson:"data_type"`
+			Day      string `bson:"day"`
+		} `bson:"_id"`
+		Count       int64     `bson:"count"`
+		Min         float64   `bson:"min"`
+		Max         float64   `bson:"max"`
+		Mean        float64   `bson:"mean"`
+		Percentiles []float64 `bson:"percentiles"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, errs.Wrap(err, "failed to decode wearable metrics")
 	}
 
-	return summaryResponse, nil
+	metrics := make(map[string][]*health.MetricStats)
+	for _, row := range rows {
+		stats := &health.MetricStats{
+			DataType: row.ID.DataType,
+			Count:    row.Count,
+			Min:      row.Min,
+			Max:      row.Max,
+			Mean:     row.Mean,
+		}
+		if len(row.Percentiles) == len(storage.SummaryPercentiles) {
+			stats.P50, stats.P90, stats.P99 = row.Percentiles[0], row.Percentiles[1], row.Percentiles[2]
+		}
+		metrics[row.ID.Day] = append(metrics[row.ID.Day], stats)
+	}
+	return metrics, nil
+}
+
+// summaryDayExpression renders created_at as a YYYY-MM-DD calendar day in loc.
+func summaryDayExpression(loc *time.Location) bson.M {
+	return bson.M{"$dateToString": bson.M{
+		"format":   "%Y-%m-%d",
+		"date":     "$created_at",
+		"timezone": loc.String(),
+	}}
 }
 
 // Helper functions to retrieve data for summaries
 
-func (r *HealthMonitoringRepo) getMedicalRecordsForSummary(ctx context.Context, filter bson.M) ([]*health.MedicalRecord, error) {
-	cursor, err := r.db.Collection("medical_records").Find(ctx, filter)
+func (r *HealthMonitoringRepo) getMedicalRecordsForSummary(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*health.MedicalRecord, error) {
+	cursor, err := r.db.Collection("medical_records").Find(ctx, filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find medical records: %w", err)
+		return nil, errs.Wrap(err, "failed to find medical records")
 	}
 	defer cursor.Close(ctx)
 
@@ -151,9 +354,12 @@ func (r *HealthMonitoringRepo) getMedicalRecordsForSummary(ctx context.Context,
 	for cursor.Next(ctx) {
 		var bsonData bson.M
 		if err := cursor.Decode(&bsonData); err != nil {
-			return nil, fmt.Errorf("failed to decode medical record: %w", err)
+			return nil, errs.Wrap(err, "failed to decode medical record")
 		}
 
+		if err := decryptMedicalRecordFields(ctx, r.cipher, bsonData); err != nil {
+			return nil, err
+		}
 		recordModel, err := bsonToMedicalRecord(bsonData)
 		if err != nil {
 			return nil, err
@@ -165,10 +371,10 @@ func (r *HealthMonitoringRepo) getMedicalRecordsForSummary(ctx context.Context,
 	return medicalRecords, nil
 }
 
-func (r *HealthMonitoringRepo) getGeneticDataForSummary(ctx context.Context, filter bson.M) ([]*health.GeneticData, error) {
-	cursor, err := r.db.Collection("genetic_data").Find(ctx, filter)
+func (r *HealthMonitoringRepo) getGeneticDataForSummary(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*health.GeneticData, error) {
+	cursor, err := r.db.Collection("genetic_data").Find(ctx, filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find genetic data: %w", err)
+		return nil, errs.Wrap(err, "failed to find genetic data")
 	}
 	defer cursor.Close(ctx)
 
@@ -176,9 +382,12 @@ func (r *HealthMonitoringRepo) getGeneticDataForSummary(ctx context.Context, fil
 	for cursor.Next(ctx) {
 		var bsonData bson.M
 		if err := cursor.Decode(&bsonData); err != nil {
-			return nil, fmt.Errorf("failed to decode genetic data: %w", err)
+			return nil, errs.Wrap(err, "failed to decode genetic data")
 		}
 
+		if err := decryptGeneticDataFields(ctx, r.cipher, bsonData); err != nil {
+			return nil, err
+		}
 		dataModel, err := bsonToGeneticData(bsonData)
 		if err != nil {
 			return nil, err
@@ -190,10 +399,10 @@ func (r *HealthMonitoringRepo) getGeneticDataForSummary(ctx context.Context, fil
 	return geneticDataRecords, nil
 }
 
-func (r *HealthMonitoringRepo) getLifestyleDataForSummary(ctx context.Context, filter bson.M) ([]*health.LifestyleData, error) {
-	cursor, err := r.db.Collection("lifestyle_data").Find(ctx, filter)
+func (r *HealthMonitoringRepo) getLifestyleDataForSummary(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*health.LifestyleData, error) {
+	cursor, err := r.db.Collection("lifestyle_data").Find(ctx, filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find lifestyle data: %w", err)
+		return nil, errs.Wrap(err, "failed to find lifestyle data")
 	}
 	defer cursor.Close(ctx)
 
@@ -201,7 +410,7 @@ func (r *HealthMonitoringRepo) getLifestyleDataForSummary(ctx context.Context, f
 	for cursor.Next(ctx) {
 		var bsonData bson.M
 		if err := cursor.Decode(&bsonData); err != nil {
-			return nil, fmt.Errorf("failed to decode lifestyle data: %w", err)
+			return nil, errs.Wrap(err, "failed to decode lifestyle data")
 		}
 
 		dataModel, err := bsonToLifestyleData(bsonData)
@@ -215,10 +424,10 @@ func (r *HealthMonitoringRepo) getLifestyleDataForSummary(ctx context.Context, f
 	return lifestyleDataRecords, nil
 }
 
-func (r *HealthMonitoringRepo) getWearableDataForSummary(ctx context.Context, filter bson.M) ([]*health.WearableData, error) {
-	cursor, err := r.db.Collection("wearable_data").Find(ctx, filter)
+func (r *HealthMonitoringRepo) getWearableDataForSummary(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*health.WearableData, error) {
+	cursor, err := r.db.Collection("wearable_data").Find(ctx, filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find wearable data: %w", err)
+		return nil, errs.Wrap(err, "failed to find wearable data")
 	}
 	defer cursor.Close(ctx)
 
@@ -226,7 +435,7 @@ func (r *HealthMonitoringRepo) getWearableDataForSummary(ctx context.Context, fi
 	for cursor.Next(ctx) {
 		var bsonData bson.M
 		if err := cursor.Decode(&bsonData); err != nil {
-			return nil, fmt.Errorf("failed to decode wearable data: %w", err)
+			return nil, errs.Wrap(err, "failed to decode wearable data")
 		}
 
 		dataModel, err := bsonToWearableData(bsonData)
@@ -240,10 +449,10 @@ func (r *HealthMonitoringRepo) getWearableDataForSummary(ctx context.Context, fi
 	return wearableDataRecords, nil
 }
 
-func (r *HealthMonitoringRepo) getHealthRecommendationsForSummary(ctx context.Context, filter bson.M) ([]*health.HealthRecommendation, error) {
-	cursor, err := r.db.Collection("health_recommendations").Find(ctx, filter)
+func (r *HealthMonitoringRepo) getHealthRecommendationsForSummary(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*health.HealthRecommendation, error) {
+	cursor, err := r.db.Collection("health_recommendations").Find(ctx, filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find health recommendations: %w", err)
+		return nil, errs.Wrap(err, "failed to find health recommendations")
 	}
 	defer cursor.Close(ctx)
 
@@ -251,7 +460,7 @@ func (r *HealthMonitoringRepo) getHealthRecommendationsForSummary(ctx context.Co
 	for cursor.Next(ctx) {
 		var bsonData bson.M
 		if err := cursor.Decode(&bsonData); err != nil {
-			return nil, fmt.Errorf("failed to decode health recommendation: %w", err)
+			return nil, errs.Wrap(err, "failed to decode health recommendation")
 		}
 
 		recommendationModel, err := bsonToHealthRecommendation(bsonData)
@@ -0,0 +1,178 @@
+package mongodb
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// pageRequest describes a single page of a keyset-paginated list query.
+type pageRequest struct {
+	size    int64
+	orderBy string // "created_at" or "_id"
+	desc    bool
+	after   *pageToken
+}
+
+// pageToken is the opaque cursor handed out as next_page_token. It holds the sort
+// keys of the last document of the previous page.
+type pageToken struct {
+	OrderBy   string `json:"o"`
+	CreatedAt int64  `json:"c,omitempty"` // Unix milliseconds, matching BSON date precision
+	ID        string `json:"i"`
+}
+
+// newPageRequest validates the pagination parameters of a list request.
+func newPageRequest(pageSize int32, token, orderBy string) (*pageRequest, error) {
+	size, err := storage.PageSize(pageSize)
+	if err != nil {
+		return nil, err
+	}
+	key, desc, err := storage.ParseOrderBy(orderBy)
+	if err != nil {
+		return nil, err
+	}
+	page := &pageRequest{size: size, orderBy: "created_at", desc: desc}
+	if key == storage.OrderByID {
+		page.orderBy = "_id"
+	}
+
+	if token != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			return nil, errs.InvalidArgument("page_token", "invalid page_token")
+		}
+		page.after = &pageToken{}
+		if err := json.Unmarshal(raw, page.after); err != nil {
+			return nil, errs.InvalidArgument("page_token", "invalid page_token")
+		}
+		if page.after.OrderBy != page.key() {
+			return nil, errs.InvalidArgument("page_token", "page_token does not match order_by")
+		}
+	}
+
+	return page, nil
+}
+
+// key identifies the sort order so that tokens cannot be replayed against another order.
+func (p *pageRequest) key() string {
+	if p.desc {
+		return p.orderBy + " desc"
+	}
+	return p.orderBy + " asc"
+}
+
+// sort returns the sort document of the page, always tie-broken by _id.
+func (p *pageRequest) sort() bson.D {
+	direction := 1
+	if p.desc {
+		direction = -1
+	}
+	if p.orderBy == "_id" {
+		return bson.D{{Key: "_id", Value: direction}}
+	}
+	return bson.D{{Key: p.orderBy, Value: direction}, {Key: "_id", Value: direction}}
+}
+
+// keysetFilter returns the condition selecting the documents after the page token.
+func (p *pageRequest) keysetFilter() (bson.M, error) {
+	if p.after == nil {
+		return nil, nil
+	}
+
+	id, err := primitive.ObjectIDFromHex(p.after.ID)
+	if err != nil {
+		return nil, errs.InvalidArgument("page_token", "invalid page_token")
+	}
+
+	op := "$gt"
+	if p.desc {
+		op = "$lt"
+	}
+	if p.orderBy == "_id" {
+		return bson.M{"_id": bson.M{op: id}}, nil
+	}
+
+	createdAt := primitive.NewDateTimeFromTime(time.UnixMilli(p.after.CreatedAt))
+	return bson.M{"$or": bson.A{
+		bson.M{p.orderBy: bson.M{op: createdAt}},
+		bson.M{p.orderBy: createdAt, "_id": bson.M{op: id}},
+	}}, nil
+}
+
+// nextToken builds the page token pointing after the given document.
+func (p *pageRequest) nextToken(doc bson.M) (string, error) {
+	token := pageToken{OrderBy: p.key()}
+
+	id, ok := doc["_id"].(primitive.ObjectID)
+	if !ok {
+		return "", fmt.Errorf("invalid _id type for pagination: %T", doc["_id"])
+	}
+	token.ID = id.Hex()
+
+	if p.orderBy == "created_at" {
+		createdAt, ok := doc["created_at"].(primitive.DateTime)
+		if !ok {
+			return "", fmt.Errorf("invalid created_at type for pagination: %T", doc["created_at"])
+		}
+		token.CreatedAt = int64(createdAt)
+	}
+
+	raw, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// findPage runs a keyset-paginated query and returns the documents of the page,
+// the token of the next page (empty on the last page) and the total number of
+// documents matching the filter.
+func findPage(ctx context.Context, collection *mongo.Collection, filter bson.M, page *pageRequest) ([]bson.M, string, int64, error) {
+	total, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, "", 0, errs.Wrap(err, "failed to count documents")
+	}
+
+	keyset, err := page.keysetFilter()
+	if err != nil {
+		return nil, "", 0, err
+	}
+	query := filter
+	if keyset != nil {
+		query = bson.M{"$and": bson.A{filter, keyset}}
+	}
+
+	// Fetch one extra document to find out whether there is a next page
+	opts := options.Find().SetSort(page.sort()).SetLimit(page.size + 1)
+	cursor, err := collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, "", 0, errs.Wrap(err, "failed to find documents")
+	}
+	defer cursor.Close(ctx)
+
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, "", 0, errs.Wrap(err, "failed to decode documents")
+	}
+
+	var next string
+	if int64(len(docs)) > page.size {
+		docs = docs[:page.size]
+		next, err = page.nextToken(docs[len(docs)-1])
+		if err != nil {
+			return nil, "", 0, err
+		}
+	}
+
+	return docs, next, total, nil
+}
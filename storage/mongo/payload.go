@@ -0,0 +1,437 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// payloadTypeKey holds the type URL of a payload stored as a BSON sub-document.
+const payloadTypeKey = "@type"
+
+// payloadToBSON stores a decoded payload as a BSON sub-document keyed by proto field
+// names and tagged with its type URL, so that its fields can be queried and aggregated.
+func payloadToBSON(typeURL string, msg proto.Message) bson.M {
+	doc := messageToBSON(msg.ProtoReflect())
+	doc[payloadTypeKey] = typeURL
+	return doc
+}
+
+// encodeDataValue converts a data_value to its stored form, a BSON sub-document
+// written by payloadToBSON, or nil without payload.
+func encodeDataValue(dataValue *anypb.Any) (interface{}, error) {
+	if dataValue == nil {
+		return nil, nil
+	}
+	msg, err := dataValue.UnmarshalNew() // Resolves the message type of the URL
+	if err != nil {
+		return nil, errs.InvalidArgument("data_value", "unsupported data_value type %q: %v", dataValue.TypeUrl, err)
+	}
+	return payloadToBSON(dataValue.TypeUrl, msg), nil
+}
+
+// payloadOperators maps the operators of payload filters to MongoDB operators.
+var payloadOperators = map[health.PayloadFilter_Operator]string{
+	health.PayloadFilter_EQ:  "$eq",
+	health.PayloadFilter_NE:  "$ne",
+	health.PayloadFilter_GT:  "$gt",
+	health.PayloadFilter_GTE: "$gte",
+	health.PayloadFilter_LT:  "$lt",
+	health.PayloadFilter_LTE: "$lte",
+}
+
+// addPayloadFilters adds the data_value filters of a list request to the filter.
+// Payloads still stored as protojson strings never match them.
+func addPayloadFilters(filter bson.M, filters []*health.PayloadFilter) error {
+	parsed, err := storage.ParsePayloadFilters(filters)
+	if err != nil {
+		return err
+	}
+	if len(parsed) == 0 {
+		return nil
+	}
+
+	conds := bson.A{}
+	for _, f := range parsed {
+		conds = append(conds, bson.M{dataValueField + "." + f.Field(): bson.M{payloadOperators[f.Op]: f.Value}})
+	}
+	filter["$and"] = conds
+	return nil
+}
+
+// decodeDataValue converts a stored data_value back to an Any message. Payloads are
+// either BSON sub-documents written by payloadToBSON or protojson strings written by
+// earlier versions.
+func decodeDataValue(value interface{}) (*anypb.Any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		dataValue := &anypb.Any{}
+		if err := protojson.Unmarshal([]byte(v), dataValue); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal data_value from JSON: %w", err)
+		}
+		return dataValue, nil
+	case bson.M:
+		typeURL, ok := v[payloadTypeKey].(string)
+		if !ok {
+			return nil, fmt.Errorf("data_value is missing its %s", payloadTypeKey)
+		}
+		dataValue := &anypb.Any{TypeUrl: typeURL}
+		msg, err := dataValue.UnmarshalNew() // Resolves the message type of the URL
+		if err != nil {
+			return nil, fmt.Errorf("unknown data_value type %q: %w", typeURL, err)
+		}
+		if err := bsonToMessage(v, msg.ProtoReflect()); err != nil {
+			return nil, fmt.Errorf("failed to decode data_value: %w", err)
+		}
+		return anypb.New(msg)
+	default:
+		return nil, fmt.Errorf("invalid data_value type: %T", value)
+	}
+}
+
+// messageToBSON converts a proto message to a BSON document keyed by proto field names.
+// Scalar fields without presence are included even when zero, so that payload filters
+// match them; other fields only when populated.
+func messageToBSON(m protoreflect.Message) bson.M {
+	doc := bson.M{}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !m.Has(fd) && (fd.HasPresence() || fd.IsList() || fd.IsMap()) {
+			continue
+		}
+		v := m.Get(fd)
+		switch {
+		case fd.IsList():
+			list := v.List()
+			arr := make(bson.A, 0, list.Len())
+			for i := 0; i < list.Len(); i++ {
+				arr = append(arr, valueToBSON(fd, list.Get(i)))
+			}
+			doc[string(fd.Name())] = arr
+		case fd.IsMap():
+			entries := bson.M{}
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				entries[k.String()] = valueToBSON(fd.MapValue(), mv)
+				return true
+			})
+			doc[string(fd.Name())] = entries
+		default:
+			doc[string(fd.Name())] = valueToBSON(fd, v)
+		}
+	}
+	return doc
+}
+
+// valueToBSON converts a single (non-repeated) proto value to its BSON representation.
+func valueToBSON(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return v.Bool()
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return int32(v.Int())
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return v.Int()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return int64(v.Uint())
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// BSON has no unsigned integers: values above MaxInt64 are stored as decimals,
+		// which MongoDB compares with the other numbers by value
+		if n := v.Uint(); n > math.MaxInt64 {
+			d, _ := primitive.ParseDecimal128(strconv.FormatUint(n, 10))
+			return d
+		}
+		return int64(v.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return v.Float()
+	case protoreflect.StringKind:
+		return v.String()
+	case protoreflect.BytesKind:
+		return v.Bytes()
+	case protoreflect.EnumKind:
+		return int32(v.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageToBSON(v.Message())
+	default:
+		return nil
+	}
+}
+
+// bsonToMessage populates a proto message from a BSON document written by messageToBSON.
+// Unknown keys are ignored.
+func bsonToMessage(doc bson.M, m protoreflect.Message) error {
+	fields := m.Descriptor().Fields()
+	for key, raw := range doc {
+		fd := fields.ByName(protoreflect.Name(key))
+		if fd == nil || raw == nil {
+			continue
+		}
+
+		switch {
+		case fd.IsList():
+			arr, ok := raw.(bson.A)
+			if !ok {
+				return fmt.Errorf("field %s: expected array, got %T", key, raw)
+			}
+			list := m.Mutable(fd).List()
+			for _, item := range arr {
+				v, err := bsonToValue(fd, item, list.NewElement)
+				if err != nil {
+					return fmt.Errorf("field %s: %w", key, err)
+				}
+				list.Append(v)
+			}
+		case fd.IsMap():
+			entries, ok := raw.(bson.M)
+			if !ok {
+				return fmt.Errorf("field %s: expected document, got %T", key, raw)
+			}
+			mp := m.Mutable(fd).Map()
+			for k, item := range entries {
+				mk, err := mapKeyFromString(fd.MapKey(), k)
+				if err != nil {
+					return fmt.Errorf("field %s: %w", key, err)
+				}
+				v, err := bsonToValue(fd.MapValue(), item, mp.NewValue)
+				if err != nil {
+					return fmt.Errorf("field %s: %w", key, err)
+				}
+				mp.Set(mk.MapKey(), v)
+			}
+		default:
+			v, err := bsonToValue(fd, raw, func() protoreflect.Value { return m.NewField(fd) })
+			if err != nil {
+				return fmt.Errorf("field %s: %w", key, err)
+			}
+			m.Set(fd, v)
+		}
+	}
+	return nil
+}
+
+// mapKeyFromString parses a BSON document key back into a proto map key.
+func mapKeyFromString(fd protoreflect.FieldDescriptor, key string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(key), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(key)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(key, 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(key, 10, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(key, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(key, 10, 64)
+		return protoreflect.ValueOfUint64(n), err
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported map key kind %s", fd.Kind())
+	}
+}
+
+// bsonToValue converts a single BSON value to a proto value of the field's kind.
+// newMessage allocates the target for message fields.
+func bsonToValue(fd protoreflect.FieldDescriptor, raw interface{}, newMessage func() protoreflect.Value) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		if b, ok := raw.(bool); ok {
+			return protoreflect.ValueOfBool(b), nil
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if n, ok := bsonInteger(raw); ok {
+			return protoreflect.ValueOfInt32(int32(n)), nil
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if n, ok := bsonInteger(raw); ok {
+			return protoreflect.ValueOfInt64(n), nil
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if n, ok := bsonInteger(raw); ok {
+			return protoreflect.ValueOfUint32(uint32(n)), nil
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if d, ok := raw.(primitive.Decimal128); ok {
+			if n, err := strconv.ParseUint(d.String(), 10, 64); err == nil {
+				return protoreflect.ValueOfUint64(n), nil
+			}
+		} else if n, ok := bsonInteger(raw); ok {
+			return protoreflect.ValueOfUint64(uint64(n)), nil
+		}
+	case protoreflect.FloatKind:
+		if n, ok := bsonNumber(raw); ok {
+			return protoreflect.ValueOfFloat32(float32(n)), nil
+		}
+	case protoreflect.DoubleKind:
+		if n, ok := bsonNumber(raw); ok {
+			return protoreflect.ValueOfFloat64(n), nil
+		}
+	case protoreflect.StringKind:
+		if s, ok := raw.(string); ok {
+			return protoreflect.ValueOfString(s), nil
+		}
+	case protoreflect.BytesKind:
+		switch b := raw.(type) {
+		case []byte:
+			return protoreflect.ValueOfBytes(b), nil
+		case primitive.Binary:
+			return protoreflect.ValueOfBytes(b.Data), nil
+		}
+	case protoreflect.EnumKind:
+		if n, ok := bsonInteger(raw); ok {
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if sub, ok := raw.(bson.M); ok {
+			v := newMessage()
+			if err := bsonToMessage(sub, v.Message()); err != nil {
+				return protoreflect.Value{}, err
+			}
+			return v, nil
+		}
+	}
+	return protoreflect.Value{}, fmt.Errorf("cannot convert %T to %s", raw, fd.Kind())
+}
+
+// bsonInteger converts any BSON numeric type to int64 without going through float64.
+func bsonInteger(raw interface{}) (int64, bool) {
+	switch n := raw.(type) {
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	case float64:
+		return int64(n), true
+	default:
+		return 0, false
+	}
+}
+
+// bsonNumber widens any BSON numeric type to float64.
+func bsonNumber(raw interface{}) (float64, bool) {
+	switch n := raw.(type) {
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+// payloadCollections lists the collections whose data_value is stored as a BSON
+// sub-document. Genetic data payloads are encrypted and stay opaque strings.
+var payloadCollections = []string{"lifestyle_data", "wearable_data"}
+
+// migratePayloadStrings converts the data_value payloads stored as protojson strings
+// by earlier versions into BSON sub-documents. Payloads whose type cannot be resolved
+// are left untouched and keep being read as strings.
+func migratePayloadStrings(ctx context.Context, db *mongo.Database) error {
+	return convertPayloads(ctx, db, "string", func(value interface{}) (interface{}, error) {
+		dataValue, err := decodeDataValue(value)
+		if err != nil {
+			return nil, err
+		}
+		return encodeDataValue(dataValue)
+	})
+}
+
+// revertPayloadStrings converts data_value sub-documents back into the protojson
+// strings stored by earlier versions, undoing migratePayloadStrings.
+func revertPayloadStrings(ctx context.Context, db *mongo.Database) error {
+	return convertPayloads(ctx, db, "object", func(value interface{}) (interface{}, error) {
+		dataValue, err := decodeDataValue(value)
+		if err != nil {
+			return nil, err
+		}
+		dataValueJSON, err := protojson.Marshal(dataValue)
+		if err != nil {
+			return nil, err
+		}
+		return string(dataValueJSON), nil
+	})
+}
+
+// migrateZeroPayloadFields rewrites the data_value sub-documents stored without their
+// zero scalar fields by earlier versions, so that payload filters match those fields.
+func migrateZeroPayloadFields(ctx context.Context, db *mongo.Database) error {
+	return convertPayloads(ctx, db, "object", func(value interface{}) (interface{}, error) {
+		dataValue, err := decodeDataValue(value)
+		if err != nil {
+			return nil, err
+		}
+		return encodeDataValue(dataValue)
+	})
+}
+
+// revertZeroPayloadFields undoes migrateZeroPayloadFields, which needs no change:
+// earlier versions read the zero fields it adds like unset ones.
+func revertZeroPayloadFields(ctx context.Context, db *mongo.Database) error {
+	return nil
+}
+
+// convertPayloads rewrites the data_value of the documents of payloadCollections
+// whose data_value has the given BSON type. Documents updated concurrently (at
+// another version) are skipped, as their writer already stored the current form.
+func convertPayloads(ctx context.Context, db *mongo.Database, bsonType string, convert func(interface{}) (interface{}, error)) error {
+	for _, name := range payloadCollections {
+		collection := db.Collection(name)
+		cursor, err := collection.Find(ctx, bson.M{dataValueField: bson.M{"$type": bsonType}})
+		if err != nil {
+			return errs.Wrap(err, "failed to find %s payloads", name)
+		}
+
+		converted := 0
+		for cursor.Next(ctx) {
+			var doc bson.M
+			if err := cursor.Decode(&doc); err != nil {
+				cursor.Close(ctx)
+				return errs.Wrap(err, "failed to decode %s", name)
+			}
+			value, err := convert(doc[dataValueField])
+			if err != nil {
+				slog.Warn(fmt.Sprintf("Skipping data_value of %s %v: %v", name, doc["_id"], err))
+				continue
+			}
+			result, err := collection.UpdateOne(ctx,
+				bson.M{"_id": doc["_id"], versionField: doc[versionField]},
+				bson.M{"$set": bson.M{dataValueField: value}})
+			if err != nil {
+				cursor.Close(ctx)
+				return errs.Wrap(err, "failed to convert data_value of %s %v", name, doc["_id"])
+			}
+			converted += int(result.ModifiedCount)
+		}
+		if err := cursor.Err(); err != nil {
+			cursor.Close(ctx)
+			return errs.Wrap(err, "failed to read %s", name)
+		}
+		cursor.Close(ctx)
+
+		if converted > 0 {
+			slog.Info(fmt.Sprintf("converted %d %s.%s payloads", converted, name, dataValueField))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/health-analytics-service/health-analytics-service/config"
+	"github.com/health-analytics-service/health-analytics-service/encryption"
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ReencryptOptions selects the key rotations performed before re-encrypting.
+type ReencryptOptions struct {
+	// Rewrap re-wraps all data keys with the active key-encryption key, so that
+	// retired KEKs can be removed from the configuration afterwards.
+	Rewrap bool
+	// RotateDataKeys creates a new data key for every user owning encrypted data.
+	RotateDataKeys bool
+}
+
+// ReencryptStats reports what a re-encryption changed.
+type ReencryptStats struct {
+	DataKeysRewrapped int
+	DataKeysRotated   int
+	MedicalRecords    int // Including archived revisions
+	GeneticData       int
+}
+
+// Reencrypt connects to the configured database and re-encrypts its sensitive fields.
+func Reencrypt(ctx context.Context, cfg config.Config, opts ReencryptOptions) (*ReencryptStats, error) {
+	db, err := Connect(cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Client().Disconnect(context.Background())
+
+	cipher, err := encryption.NewCipherFromConfig(cfg, NewDataKeyStore(db))
+	if err != nil {
+		return nil, err
+	}
+	return ReencryptDatabase(ctx, db, cipher, opts)
+}
+
+// ReencryptDatabase encrypts the sensitive fields still stored in plaintext and
+// re-encrypts those encrypted with an older data key (or another user's key) with
+// the latest data key of their owner. Blind indexes are recomputed as well.
+// Documents modified concurrently are skipped, as their writer already encrypted
+// them with the latest key.
+func ReencryptDatabase(ctx context.Context, db *mongo.Database, cipher *encryption.Cipher, opts ReencryptOptions) (*ReencryptStats, error) {
+	stats := &ReencryptStats{}
+	var err error
+
+	if opts.Rewrap {
+		if stats.DataKeysRewrapped, err = cipher.RewrapDataKeys(ctx); err != nil {
+			return stats, err
+		}
+	}
+
+	if opts.RotateDataKeys {
+		users := make(map[string]struct{})
+		for _, collection := range []string{"medical_records", "genetic_data"} {
+			ids, err := db.Collection(collection).Distinct(ctx, "user_id", bson.M{})
+			if err != nil {
+				return stats, errs.Wrap(err, "failed to list users of %s", collection)
+			}
+			for _, id := range ids {
+				if userID, ok := id.(string); ok && userID != "" {
+					users[userID] = struct{}{}
+				}
+			}
+		}
+		for userID := range users {
+			if _, err := cipher.RotateDataKey(ctx, userID); err != nil {
+				return stats, err
+			}
+			stats.DataKeysRotated++
+		}
+	}
+
+	if stats.MedicalRecords, err = reencryptCollection(ctx, db.Collection("medical_records"), func(doc bson.M) (bson.M, error) {
+		return reencryptMedicalRecord(ctx, cipher, doc)
+	}); err != nil {
+		return stats, err
+	}
+	history, err := reencryptCollection(ctx, db.Collection("medical_records_history"), func(doc bson.M) (bson.M, error) {
+		return reencryptMedicalRecord(ctx, cipher, doc)
+	})
+	stats.MedicalRecords += history
+	if err != nil {
+		return stats, err
+	}
+	if stats.GeneticData, err = reencryptCollection(ctx, db.Collection("genetic_data"), func(doc bson.M) (bson.M, error) {
+		return reencryptGeneticData(ctx, cipher, doc)
+	}); err != nil {
+		return stats, err
+	}
+	return stats, nil
+}
+
+// reencryptCollection applies the updates returned by reencrypt to every document of
+// the collection and returns the number of documents updated. The update only applies
+// if the re-encrypted fields still hold the values that were read.
+func reencryptCollection(ctx context.Context, collection *mongo.Collection, reencrypt func(bson.M) (bson.M, error)) (int, error) {
+	cursor, err := collection.Find(ctx, bson.M{})
+	if err != nil {
+		return 0, errs.Wrap(err, "failed to find %s", collection.Name())
+	}
+	defer cursor.Close(ctx)
+
+	var updated int
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			return updated, errs.Wrap(err, "failed to decode %s", collection.Name())
+		}
+
+		filter := bson.M{"_id": doc["_id"]}
+		for _, field := range []string{descriptionField, attachmentsField, dataValueField} {
+			if value, ok := doc[field]; ok {
+				filter[field] = value
+			}
+		}
+
+		set, err := reencrypt(doc)
+		if err != nil {
+			return updated, err
+		}
+		if len(set) == 0 {
+			continue
+		}
+
+		result, err := collection.UpdateOne(ctx, filter, bson.M{"$set": set})
+		if err != nil {
+			return updated, errs.Wrap(err, "failed to re-encrypt %s", collection.Name())
+		}
+		updated += int(result.ModifiedCount)
+	}
+	if err := cursor.Err(); err != nil {
+		return updated, errs.Wrap(err, "failed to find %s", collection.Name())
+	}
+	return updated, nil
+}
+
+// reencryptMedicalRecord returns the re-encrypted fields of a stored medical record,
+// or nil when it is up to date.
+func reencryptMedicalRecord(ctx context.Context, cipher *encryption.Cipher, doc bson.M) (bson.M, error) {
+	userID, _ := doc["user_id"].(string)
+	stale, err := needsReencryption(ctx, cipher, userID, doc[descriptionField])
+	if err != nil {
+		return nil, err
+	}
+	if attachments, ok := doc[attachmentsField].(bson.A); ok {
+		for _, attachment := range attachments {
+			attachmentStale, err := needsReencryption(ctx, cipher, userID, attachment)
+			if err != nil {
+				return nil, err
+			}
+			stale = stale || attachmentStale
+		}
+	}
+
+	if err := decryptMedicalRecordFields(ctx, cipher, doc); err != nil {
+		return nil, err
+	}
+	description, _ := doc[descriptionField].(string)
+	if !stale && doc[descriptionIndexField] == cipher.BlindIndex(descriptionField, description) {
+		return nil, nil
+	}
+
+	var attachments []string
+	if values, ok := doc[attachmentsField].(bson.A); ok {
+		for _, value := range values {
+			if attachment, ok := value.(string); ok {
+				attachments = append(attachments, attachment)
+			}
+		}
+	}
+	set := bson.M{}
+	if err := encryptMedicalRecordFields(ctx, cipher, userID, description, attachments, set); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// reencryptGeneticData returns the re-encrypted data_value of stored genetic data, or
+// nil when it is up to date.
+func reencryptGeneticData(ctx context.Context, cipher *encryption.Cipher, doc bson.M) (bson.M, error) {
+	userID, _ := doc["user_id"].(string)
+	stale, err := needsReencryption(ctx, cipher, userID, doc[dataValueField])
+	if err != nil || !stale {
+		return nil, err
+	}
+
+	if err := decryptGeneticDataFields(ctx, cipher, doc); err != nil {
+		return nil, err
+	}
+	encrypted, err := cipher.Encrypt(ctx, userID, dataValueField, doc[dataValueField].(string))
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to encrypt data_value")
+	}
+	return bson.M{dataValueField: encrypted}, nil
+}
+
+// needsReencryption reports whether a stored string value must be re-encrypted.
+func needsReencryption(ctx context.Context, cipher *encryption.Cipher, userID string, value interface{}) (bool, error) {
+	str, ok := value.(string)
+	if !ok {
+		return false, nil
+	}
+	return cipher.NeedsReencryption(ctx, userID, str)
+}
@@ -0,0 +1,147 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Soft-deletion markers. Deleted documents keep all their fields until purged.
+const (
+	deletedAtField = "deleted_at"
+	deletedByField = "deleted_by"
+)
+
+// softDeleteCollections lists the collections whose documents are soft-deleted.
+var softDeleteCollections = []string{
+	"medical_records",
+	"genetic_data",
+	"lifestyle_data",
+	"wearable_data",
+	"health_recommendations",
+}
+
+// historyCollections maps the collections keeping prior revisions of their documents
+// to the collection of those revisions, which are purged along with the document.
+var historyCollections = map[string]string{
+	"medical_records": "medical_records_history",
+}
+
+// notDeleted restricts filter to documents that are not soft-deleted. A missing or
+// null deleted_at both match.
+func notDeleted(filter bson.M) bson.M {
+	filter[deletedAtField] = nil
+	return filter
+}
+
+// byID returns the filter of a document by id, excluding soft-deleted documents
+// unless ctx includes them.
+func byID(ctx context.Context, objID primitive.ObjectID) bson.M {
+	filter := bson.M{"_id": objID}
+	if storage.IncludesDeleted(ctx) {
+		return filter
+	}
+	return notDeleted(filter)
+}
+
+// softDelete marks a document as deleted by the actor of ctx. Documents already
+// deleted are reported as not found.
+func softDelete(ctx context.Context, collection *mongo.Collection, resource string, objID primitive.ObjectID) error {
+	result, err := collection.UpdateOne(ctx, notDeleted(bson.M{"_id": objID}), bson.M{"$set": bson.M{
+		deletedAtField: time.Now(),
+		deletedByField: storage.ActorFromContext(ctx),
+	}})
+	if err != nil {
+		return errs.Wrap(err, "failed to delete %s", resource)
+	}
+	if result.MatchedCount == 0 {
+		return errs.NotFound(resource, objID.Hex())
+	}
+	return nil
+}
+
+// restore clears the deletion markers of a soft-deleted document and returns it.
+func restore(ctx context.Context, collection *mongo.Collection, resource string, objID primitive.ObjectID) (bson.M, error) {
+	var restored bson.M
+	err := collection.FindOneAndUpdate(ctx,
+		bson.M{"_id": objID, deletedAtField: bson.M{"$ne": nil}},
+		bson.M{
+			"$set":   bson.M{"updated_at": time.Now()},
+			"$unset": bson.M{deletedAtField: "", deletedByField: ""},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&restored)
+	if err == nil {
+		return restored, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, errs.Wrap(err, "failed to restore %s", resource)
+	}
+
+	// Tell documents that are not deleted apart from missing (or purged) ones
+	count, err := collection.CountDocuments(ctx, bson.M{"_id": objID})
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to restore %s", resource)
+	}
+	if count > 0 {
+		return nil, errs.FailedPrecondition(resource+"/"+objID.Hex(), "%s %s is not deleted", resource, objID.Hex())
+	}
+	return nil, errs.NotFound(resource, objID.Hex())
+}
+
+// RetentionRepo implements the storage.RetentionRepoI interface for MongoDB.
+type RetentionRepo struct {
+	db *mongo.Database
+}
+
+// NewRetentionRepo creates a new RetentionRepo instance.
+func NewRetentionRepo(db *mongo.Database) *RetentionRepo {
+	return &RetentionRepo{
+		db: db,
+	}
+}
+
+// PurgeDeleted permanently removes the documents soft-deleted before deletedBefore
+// from all soft-deleted collections, along with their prior revisions. The documents
+// are deleted first and only the revisions of documents that are actually gone are
+// purged, so a document restored concurrently keeps its history.
+func (r *RetentionRepo) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var purged int64
+	for _, collection := range softDeleteCollections {
+		filter := bson.M{deletedAtField: bson.M{"$lt": deletedBefore}}
+
+		history, hasHistory := historyCollections[collection]
+		var ids []interface{}
+		if hasHistory {
+			var err error
+			if ids, err = r.db.Collection(collection).Distinct(ctx, "_id", filter); err != nil {
+				return purged, errs.Wrap(err, "failed to find deleted %s", collection)
+			}
+		}
+
+		result, err := r.db.Collection(collection).DeleteMany(ctx, filter)
+		if err != nil {
+			return purged, errs.Wrap(err, "failed to purge deleted %s", collection)
+		}
+		purged += result.DeletedCount
+
+		if len(ids) > 0 {
+			remaining, err := r.db.Collection(collection).Distinct(ctx, "_id", bson.M{"_id": bson.M{"$in": ids}})
+			if err != nil {
+				return purged, errs.Wrap(err, "failed to find purged %s", collection)
+			}
+			if remaining == nil {
+				remaining = []interface{}{} // $nin requires an array
+			}
+			if _, err := r.db.Collection(history).DeleteMany(ctx, bson.M{"record_id": bson.M{"$in": ids, "$nin": remaining}}); err != nil {
+				return purged, errs.Wrap(err, "failed to purge %s", history)
+			}
+		}
+	}
+	return purged, nil
+}
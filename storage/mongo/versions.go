@@ -0,0 +1,117 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// versionField holds the revision of a document, incremented by every update.
+	versionField = "version"
+	// maxUpdateAttempts bounds how often an update is retried when the document is
+	// modified concurrently.
+	maxUpdateAttempts = 5
+)
+
+// documentVersion returns the revision of a stored document. Documents written
+// before versioning are at version 1.
+func documentVersion(doc bson.M) int64 {
+	switch v := doc[versionField].(type) {
+	case int64:
+		return v
+	case int32:
+		return int64(v)
+	default:
+		return 1
+	}
+}
+
+// versionFilter matches documents at the given revision, including unversioned
+// documents for version 1.
+func versionFilter(version int64) interface{} {
+	if version == 1 {
+		return bson.M{"$in": bson.A{int64(1), nil}}
+	}
+	return version
+}
+
+// staleVersion reports an update whose expected version is not the current one.
+func staleVersion(resource, id string, expected, current int64) error {
+	return errs.FailedPrecondition(resource+"/"+id, "%s %s is at version %d, not %d", resource, id, current, expected)
+}
+
+// concurrentModification reports an update that kept losing races with other writers.
+func concurrentModification(resource, id string) error {
+	return errs.Aborted("CONCURRENT_MODIFICATION", "%s %s was modified concurrently", resource, id)
+}
+
+// updateVersioned updates a document that is not soft-deleted with the update built
+// from its current state and version, and increments its version. The update only
+// applies if the document was not modified since it was read; otherwise it is read
+// and built again, so that an expected version (if not 0) is checked against the new
+// state and fails with FailedPrecondition. It returns the updated document.
+func updateVersioned(ctx context.Context, collection *mongo.Collection, resource string, objID primitive.ObjectID, expected int64,
+	build func(current bson.M, version int64) (bson.M, error)) (bson.M, error) {
+	return updateArchived(ctx, collection, resource, objID, expected, build, nil)
+}
+
+// updateArchived is updateVersioned for collections keeping the prior revisions of
+// their documents. Before each update attempt, archive is called with the current
+// revision, so that no update applies without its revision being archived. archive
+// must be idempotent: an attempt that loses a race or fails leaves the revision it
+// archived in the history, at the version still current, so readers of the history
+// only consider versions below the current one. A nil archive keeps no revision.
+func updateArchived(ctx context.Context, collection *mongo.Collection, resource string, objID primitive.ObjectID, expected int64,
+	build func(current bson.M, version int64) (bson.M, error), archive func(current bson.M, version int64) error) (bson.M, error) {
+	for attempt := 1; ; attempt++ {
+		var current bson.M
+		err := collection.FindOne(ctx, notDeleted(bson.M{"_id": objID})).Decode(&current)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, errs.NotFound(resource, objID.Hex())
+			}
+			return nil, errs.Wrap(err, "failed to get %s by ID", resource)
+		}
+		version := documentVersion(current)
+		if expected != 0 && expected != version {
+			return nil, staleVersion(resource, objID.Hex(), expected, version)
+		}
+
+		update, err := build(current, version)
+		if err != nil {
+			return nil, err
+		}
+		set, ok := update["$set"].(bson.M)
+		if !ok {
+			set = bson.M{}
+			update["$set"] = set
+		}
+		set[versionField] = version + 1
+
+		if archive != nil {
+			if err := archive(current, version); err != nil {
+				return nil, err
+			}
+		}
+
+		var updated bson.M
+		err = collection.FindOneAndUpdate(ctx,
+			notDeleted(bson.M{"_id": objID, versionField: versionFilter(version)}),
+			update,
+			options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updated)
+		if err == nil {
+			return updated, nil
+		}
+		if err != mongo.ErrNoDocuments {
+			return nil, errs.Wrap(err, "failed to update %s", resource)
+		}
+		if attempt == maxUpdateAttempts {
+			return nil, concurrentModification(resource, objID.Hex())
+		}
+	}
+}
@@ -5,14 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/health-analytics-service/health-analytics-service/errs"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/encoding/protojson"
-	"google.golang.org/protobuf/types/known/anypb"
 )
 
 // WearableDataRepo implements the storage.WearableDataRepoI interface for MongoDB.
@@ -28,7 +26,7 @@ func NewWearableDataRepo(db *mongo.Database) *WearableDataRepo {
 }
 
 // CreateWearableData creates a new wearable data record in the database.
-func (r *WearableDataRepo) CreateWearableData(ctx context.Context, data *health.WearableData) (string, error) {
+func (r *WearableDataRepo) CreateWearableData(ctx context.Context, data *health.WearableData) (*health.WearableData, error) {
 	var (
 		objectID primitive.ObjectID
 		err      error
@@ -37,16 +35,21 @@ func (r *WearableDataRepo) CreateWearableData(ctx context.Context, data *health.
 	if data.Id != "" {
 		objectID, err = primitive.ObjectIDFromHex(data.Id)
 		if err != nil {
-			return "", err
+			return nil, errs.InvalidArgument("id", "invalid wearable data ID: %v", err)
 		}
 	} else {
 		objectID = primitive.NewObjectID()
 	}
 
-	// Convert the Any proto message to a JSON string
-	dataValueJSON, err := protojson.Marshal(data.DataValue)
+	// Validate the payload and convert it to its stored form
+	dataValue, value, err := encodeWearableDataValue(data)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	recordedTimestamp, err := timestampValue("recorded_timestamp", data.RecordedTimestamp)
+	if err != nil {
+		return nil, err
 	}
 
 	// Convert the model to a BSON document
@@ -55,24 +58,31 @@ func (r *WearableDataRepo) CreateWearableData(ctx context.Context, data *health.
 		"user_id":            data.UserId,
 		"device_type":        data.DeviceType,
 		"data_type":          data.DataType,
-		"data_value":         string(dataValueJSON), // Store as string
-		"recorded_timestamp": data.RecordedTimestamp,
+		"data_value":         dataValue,
+		"recorded_timestamp": recordedTimestamp,
 		"created_at":         time.Now(),
 		"updated_at":         time.Now(),
+		versionField:         int64(1),
+	}
+	if value != nil {
+		bsonData["value"] = *value // Numeric reading used by summary aggregations
 	}
 
 	// Insert the document into the collection
 	result, err := r.db.Collection("wearable_data").InsertOne(ctx, bsonData)
 	if err != nil {
-		return "", fmt.Errorf("failed to create wearable data: %w", err)
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, errs.AlreadyExists("wearable data", objectID.Hex())
+		}
+		return nil, errs.Wrap(err, "failed to create wearable data")
 	}
 
 	// Get the inserted ID as a string
 	insertedID, ok := result.InsertedID.(primitive.ObjectID)
 	if !ok {
-		return "", fmt.Errorf("failed to convert inserted ID to string")
+		return nil, fmt.Errorf("failed to convert inserted ID to string")
 	}
-	return insertedID.Hex(), nil
+	return r.GetWearableData(ctx, insertedID.Hex())
 }
 
 // GetWearableData retrieves a wearable data record by its ID.
@@ -80,17 +90,17 @@ func (r *WearableDataRepo) GetWearableData(ctx context.Context, id string) (*hea
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid wearable data ID: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid wearable data ID: %v", err)
 	}
 
 	// Find the document by ID
 	var bsonData bson.M
-	err = r.db.Collection("wearable_data").FindOne(ctx, bson.M{"_id": objID}).Decode(&bsonData)
+	err = r.db.Collection("wearable_data").FindOne(ctx, byID(ctx, objID)).Decode(&bsonData)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, status.Errorf(codes.NotFound, "wearable data not found")
+			return nil, errs.NotFound("wearable data", objID.Hex())
 		}
-		return nil, fmt.Errorf("failed to get wearable data by ID: %w", err)
+		return nil, errs.Wrap(err, "failed to get wearable data by ID")
 	}
 
 	// Convert the BSON document to a proto message
@@ -101,68 +111,92 @@ func (r *WearableDataRepo) GetWearableData(ctx context.Context, id string) (*hea
 	return dataModel, nil
 }
 
-// UpdateWearableData updates an existing wearable data record in the database.
-func (r *WearableDataRepo) UpdateWearableData(ctx context.Context, data *health.WearableData) error {
+// UpdateWearableData updates an existing wearable data record in the database. With an
+// update_mask, only the masked fields are written; data_type and data_value always go
+// together.
+func (r *WearableDataRepo) UpdateWearableData(ctx context.Context, data *health.WearableData) (*health.WearableData, error) {
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(data.Id)
 	if err != nil {
-		return fmt.Errorf("invalid wearable data ID: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid wearable data ID: %v", err)
 	}
 
-	// Convert the Any proto message to a JSON string
-	dataValueJSON, err := protojson.Marshal(data.DataValue)
-	if err != nil {
-		return err
-	}
+	masked := func(field string) bool { return storage.MaskIncludes(data.UpdateMask, field) }
 
-	// Convert the model to a BSON document
+	// Convert the masked fields of the model to a BSON document
 	bsonData := bson.M{
-		"user_id":            data.UserId,
-		"device_type":        data.DeviceType,
-		"data_type":          data.DataType,
-		"data_value":         string(dataValueJSON), // Store as string
-		"recorded_timestamp": data.RecordedTimestamp,
-		"updated_at":         time.Now(),
+		"updated_at": time.Now(),
 	}
-
-	// Update the document in the collection
-	result, err := r.db.Collection("wearable_data").UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": bsonData})
-	if err != nil {
-		return fmt.Errorf("failed to update wearable data: %w", err)
+	update := bson.M{"$set": bsonData}
+	if masked("user_id") {
+		bsonData["user_id"] = data.UserId
+	}
+	if masked("device_type") {
+		bsonData["device_type"] = data.DeviceType
+	}
+	if masked("data_type") || masked("data_value") {
+		// Validate the payload and convert it to its stored form
+		dataValue, value, err := encodeWearableDataValue(data)
+		if err != nil {
+			return nil, err
+		}
+		bsonData["data_type"] = data.DataType
+		bsonData["data_value"] = dataValue
+		if value != nil {
+			bsonData["value"] = *value // Numeric reading used by summary aggregations
+		} else {
+			update["$unset"] = bson.M{"value": ""}
+		}
+	}
+	if masked("recorded_timestamp") {
+		if bsonData["recorded_timestamp"], err = timestampValue("recorded_timestamp", data.RecordedTimestamp); err != nil {
+			return nil, err
+		}
 	}
 
-	if result.ModifiedCount == 0 {
-		return status.Errorf(codes.NotFound, "wearable data not found")
+	// Update the document in the collection, unless it changed since it was read
+	updated, err := updateVersioned(ctx, r.db.Collection("wearable_data"), "wearable data", objID, data.ExpectedVersion,
+		func(bson.M, int64) (bson.M, error) {
+			return update, nil
+		})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return bsonToWearableData(updated)
 }
 
-// DeleteWearableData deletes a wearable data record from the database.
+// DeleteWearableData soft-deletes a wearable data record. It is excluded from all reads and can be
+// restored until it is purged by the retention job.
 func (r *WearableDataRepo) DeleteWearableData(ctx context.Context, id string) error {
 	// Convert the string ID to an ObjectID
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return fmt.Errorf("invalid wearable data ID: %w", err)
+		return errs.InvalidArgument("id", "invalid wearable data ID: %v", err)
 	}
 
-	// Delete the document from the collection
-	result, err := r.db.Collection("wearable_data").DeleteOne(ctx, bson.M{"_id": objID})
+	return softDelete(ctx, r.db.Collection("wearable_data"), "wearable data", objID)
+}
+
+// RestoreWearableData restores a wearable data record that was soft-deleted and not purged yet.
+func (r *WearableDataRepo) RestoreWearableData(ctx context.Context, id string) (*health.WearableData, error) {
+	// Convert the string ID to an ObjectID
+	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return fmt.Errorf("failed to delete wearable data: %w", err)
+		return nil, errs.InvalidArgument("id", "invalid wearable data ID: %v", err)
 	}
 
-	if result.DeletedCount == 0 {
-		return status.Errorf(codes.NotFound, "wearable data not found")
+	restored, err := restore(ctx, r.db.Collection("wearable_data"), "wearable data", objID)
+	if err != nil {
+		return nil, err
 	}
-
-	return nil
+	return bsonToWearableData(restored)
 }
 
 // ListWearableData retrieves all wearable data records for a given user ID, applying filters if provided.
-func (r *WearableDataRepo) ListWearableData(ctx context.Context, req *health.ListWearableDataRequest) ([]*health.WearableData, error) {
+func (r *WearableDataRepo) ListWearableData(ctx context.Context, req *health.ListWearableDataRequest) (*health.ListWearableDataResponse, error) {
 	// Build the filter query based on the request parameters
-	filter := bson.M{}
+	filter := notDeleted(bson.M{})
 	if req.UserId != "" {
 		filter["user_id"] = req.UserId
 	}
@@ -172,35 +206,66 @@ func (r *WearableDataRepo) ListWearableData(ctx context.Context, req *health.Lis
 	if req.DataType != "" {
 		filter["data_type"] = req.DataType
 	}
-	if req.RecordedTimestamp != "" {
-		filter["recorded_timestamp"] = req.RecordedTimestamp
+	if err := addTimestampRangeFilter(filter, "recorded_timestamp", req.RecordedTimestamp, req.RecordedTimestampFrom, req.RecordedTimestampTo); err != nil {
+		return nil, err
+	}
+	if err := addPayloadFilters(filter, req.DataValueFilters); err != nil {
+		return nil, err
 	}
 
-	// Find the documents based on the filter
-	cursor, err := r.db.Collection("wearable_data").Find(ctx, filter)
+	page, err := newPageRequest(req.PageSize, req.PageToken, req.OrderBy)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list wearable data: %w", err)
+		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	var wearableDataRecords []*health.WearableData
+	// Find the documents of the requested page
+	docs, nextPageToken, totalSize, err := findPage(ctx, r.db.Collection("wearable_data"), filter, page)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list wearable data")
+	}
 
-	// Iterate through the cursor and convert each document to a proto message
-	for cursor.Next(ctx) {
-		var bsonData bson.M
-		if err := cursor.Decode(&bsonData); err != nil {
-			return nil, fmt.Errorf("failed to decode wearable data: %w", err)
-		}
+	response := &health.ListWearableDataResponse{
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}
 
+	// Convert each document to a proto message
+	for _, bsonData := range docs {
 		dataModel, err := bsonToWearableData(bsonData)
 		if err != nil {
 			return nil, err
 		}
 
-		wearableDataRecords = append(wearableDataRecords, dataModel)
+		response.WearableData = append(response.WearableData, dataModel)
 	}
 
-	return wearableDataRecords, nil
+	return response, nil
+}
+
+// encodeWearableDataValue validates data_value against the data type and returns its
+// stored form, a BSON sub-document, along with the numeric reading used by summary
+// aggregations, if any.
+func encodeWearableDataValue(data *health.WearableData) (interface{}, *float64, error) {
+	msg, _, err := storage.UnpackWearablePayload(data.DataType, data.DataValue)
+	if err != nil {
+		return nil, nil, err
+	}
+	stored, err := encodeDataValue(data.DataValue)
+	if err != nil {
+		return nil, nil, err
+	}
+	if msg == nil && data.DataValue != nil {
+		msg, _ = data.DataValue.UnmarshalNew()
+	}
+
+	if msg == nil {
+		return stored, nil, nil
+	}
+	value, ok := storage.WearableValue(msg)
+	if !ok {
+		return stored, nil, nil
+	}
+	return stored, &value, nil
 }
 
 // bsonToWearableData converts a BSON document to a health.WearableData proto message.
@@ -226,18 +291,14 @@ func bsonToWearableData(bsonData bson.M) (*health.WearableData, error) {
 	if val, ok := bsonData["data_type"].(string); ok {
 		dataModel.DataType = val
 	}
-	if val, ok := bsonData["recorded_timestamp"].(string); ok {
-		dataModel.RecordedTimestamp = val
-	}
+	dataModel.RecordedTimestamp = formatTimestamp(bsonData["recorded_timestamp"])
 
-	// Convert data_value from string to Any proto message
-	if val, ok := bsonData["data_value"].(string); ok {
-		dataModel.DataValue = &anypb.Any{}
-		err := protojson.Unmarshal([]byte(val), dataModel.DataValue)
-		if err != nil {
-			return nil, fmt.Errorf("failed to unmarshal data_value from JSON: %w", err)
-		}
+	// Convert data_value from its stored form to Any proto message
+	dataValue, err := decodeDataValue(bsonData["data_value"])
+	if err != nil {
+		return nil, err
 	}
+	dataModel.DataValue = dataValue
 
 	// Convert created_at and updated_at fields
 	if val, ok := bsonData["created_at"].(primitive.DateTime); ok {
@@ -246,6 +307,7 @@ func bsonToWearableData(bsonData bson.M) (*health.WearableData, error) {
 	if val, ok := bsonData["updated_at"].(primitive.DateTime); ok {
 		dataModel.UpdatedAt = val.Time().Format(time.RFC3339)
 	}
+	dataModel.Version = documentVersion(bsonData)
 
 	return dataModel, nil
 }
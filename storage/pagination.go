@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"strings"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+)
+
+const (
+	// DefaultPageSize is used when a list request does not specify a page size.
+	DefaultPageSize = 50
+	// MaxPageSize caps the page size of list requests.
+	MaxPageSize = 1000
+)
+
+// Sort keys of list requests.
+const (
+	OrderByCreatedAt = "created_at"
+	OrderByID        = "id"
+)
+
+// PageSize validates the page_size of a list request and applies its default and cap.
+func PageSize(pageSize int32) (int64, error) {
+	if pageSize < 0 {
+		return 0, errs.InvalidArgument("page_size", "page_size must not be negative")
+	}
+	if pageSize == 0 {
+		return DefaultPageSize, nil
+	}
+	return int64(min(pageSize, MaxPageSize)), nil
+}
+
+// ParseOrderBy validates the order_by of a list request ("created_at" or "id",
+// optionally followed by "asc" or "desc") and returns its sort key and direction.
+// A key without a direction sorts ascending, as in SQL; an empty order_by sorts
+// newest first.
+func ParseOrderBy(orderBy string) (string, bool, error) {
+	if orderBy == "" {
+		return OrderByCreatedAt, true, nil
+	}
+
+	parts := strings.Fields(strings.ToLower(orderBy))
+	if len(parts) > 2 {
+		return "", false, errs.InvalidArgument("order_by", "invalid order_by %q", orderBy)
+	}
+	var key string
+	switch parts[0] {
+	case "created_at":
+		key = OrderByCreatedAt
+	case "id", "_id":
+		key = OrderByID
+	default:
+		return "", false, errs.InvalidArgument("order_by", "invalid order_by field %q: must be created_at or id", parts[0])
+	}
+	desc := false
+	if len(parts) == 2 {
+		switch parts[1] {
+		case "asc":
+		case "desc":
+			desc = true
+		default:
+			return "", false, errs.InvalidArgument("order_by", "invalid order_by direction %q: must be asc or desc", parts[1])
+		}
+	}
+	return key, desc, nil
+}
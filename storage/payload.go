@@ -0,0 +1,196 @@
+package storage
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// MaxPayloadFilters is the maximum number of data_value filters of a list request.
+const MaxPayloadFilters = 10
+
+// payloadFieldName matches a proto field name in the path of a payload filter.
+var payloadFieldName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// PayloadFilter is a validated filter on a field of data_value payloads.
+type PayloadFilter struct {
+	Path  []string // Proto field names from the payload root
+	Op    health.PayloadFilter_Operator
+	Value interface{} // float64, string or bool
+}
+
+// Field returns the dotted path of the filtered field.
+func (f PayloadFilter) Field() string {
+	return strings.Join(f.Path, ".")
+}
+
+// ParsePayloadFilters validates the data_value filters of a list request.
+func ParsePayloadFilters(filters []*health.PayloadFilter) ([]PayloadFilter, error) {
+	if len(filters) > MaxPayloadFilters {
+		return nil, errs.InvalidArgument("data_value_filters", "at most %d data_value_filters are allowed", MaxPayloadFilters)
+	}
+
+	parsed := make([]PayloadFilter, 0, len(filters))
+	for i, filter := range filters {
+		field := fmt.Sprintf("data_value_filters[%d]", i)
+
+		path := strings.Split(filter.Field, ".")
+		for _, name := range path {
+			if !payloadFieldName.MatchString(name) {
+				return nil, errs.InvalidArgument(field+".field", "invalid payload field %q", filter.Field)
+			}
+		}
+		if _, ok := health.PayloadFilter_Operator_name[int32(filter.Op)]; !ok {
+			return nil, errs.InvalidArgument(field+".op", "invalid operator %d", filter.Op)
+		}
+
+		var value interface{}
+		switch v := filter.Value.GetKind().(type) {
+		case *structpb.Value_NumberValue:
+			value = v.NumberValue
+		case *structpb.Value_StringValue:
+			value = v.StringValue
+		case *structpb.Value_BoolValue:
+			if filter.Op != health.PayloadFilter_EQ && filter.Op != health.PayloadFilter_NE {
+				return nil, errs.InvalidArgument(field+".value", "%s needs a number or string value", filter.Op)
+			}
+			value = v.BoolValue
+		default:
+			return nil, errs.InvalidArgument(field+".value", "value must be a number, string or bool")
+		}
+
+		parsed = append(parsed, PayloadFilter{Path: path, Op: filter.Op, Value: value})
+	}
+	return parsed, nil
+}
+
+// RejectPayloadFilters rejects the data_value filters of a list request whose
+// payloads are encrypted, and so cannot be filtered on.
+func RejectPayloadFilters(filters []*health.PayloadFilter) error {
+	if len(filters) > 0 {
+		return errs.InvalidArgument("data_value_filters", "data_value_filters are not supported: the payloads are encrypted")
+	}
+	return nil
+}
+
+// MatchPayload reports whether a payload satisfies all filters. It follows the
+// semantics of the MongoDB storage: fields that are missing, unset or of another type
+// than the value only match NE, and repeated or map fields never match. Scalar fields
+// without presence are set even when zero. Enums compare as their numbers and all
+// integers as float64 values.
+func MatchPayload(payload *anypb.Any, filters []PayloadFilter) bool {
+	if len(filters) == 0 {
+		return true
+	}
+
+	var msg protoreflect.Message
+	if payload != nil {
+		if m, err := payload.UnmarshalNew(); err == nil {
+			msg = m.ProtoReflect()
+		}
+	}
+	for _, filter := range filters {
+		if !filter.match(payloadField(msg, filter.Path)) {
+			return false
+		}
+	}
+	return true
+}
+
+// payloadField returns the value of the field at path as a float64, string or bool,
+// or nil when it is missing, unset or not a scalar.
+func payloadField(msg protoreflect.Message, path []string) interface{} {
+	for i, name := range path {
+		if msg == nil {
+			return nil
+		}
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil || fd.IsList() || fd.IsMap() || (fd.HasPresence() && !msg.Has(fd)) {
+			return nil
+		}
+
+		v := msg.Get(fd)
+		if i < len(path)-1 {
+			if fd.Kind() != protoreflect.MessageKind {
+				return nil
+			}
+			msg = v.Message()
+			continue
+		}
+
+		switch fd.Kind() {
+		case protoreflect.BoolKind:
+			return v.Bool()
+		case protoreflect.StringKind:
+			return v.String()
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+			protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			return float64(v.Int())
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			return float64(v.Uint())
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			return v.Float()
+		case protoreflect.EnumKind:
+			return float64(v.Enum())
+		}
+	}
+	return nil
+}
+
+// match compares a field value returned by payloadField with the filter.
+func (f PayloadFilter) match(field interface{}) bool {
+	cmp, ok := compareScalars(field, f.Value)
+	switch f.Op {
+	case health.PayloadFilter_EQ:
+		return ok && cmp == 0
+	case health.PayloadFilter_NE:
+		return !ok || cmp != 0
+	case health.PayloadFilter_GT:
+		return ok && cmp > 0
+	case health.PayloadFilter_GTE:
+		return ok && cmp >= 0
+	case health.PayloadFilter_LT:
+		return ok && cmp < 0
+	case health.PayloadFilter_LTE:
+		return ok && cmp <= 0
+	}
+	return false
+}
+
+// compareScalars compares two values of the same type, reporting false for values
+// of different types.
+func compareScalars(a, b interface{}) (int, bool) {
+	switch a := a.(type) {
+	case float64:
+		b, ok := b.(float64)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case a < b:
+			return -1, true
+		case a > b:
+			return 1, true
+		}
+		return 0, true
+	case string:
+		b, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(a, b), true
+	case bool:
+		b, ok := b.(bool)
+		if !ok || a != b {
+			return 1, ok
+		}
+		return 0, true
+	}
+	return 0, false
+}
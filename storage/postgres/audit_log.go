@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"github.com/lib/pq"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// auditLog is the table of audit entries.
+var auditLog = table{
+	name:     "audit_log",
+	resource: "audit entry",
+	columns:  "id, actor, actor_roles, action, resource_type, resource_id, user_id, outcome, source, method, error, created_at",
+}
+
+// AuditLogRepo implements the storage.AuditLogRepoI interface for PostgreSQL. The
+// audit_log table is append-only: entries are inserted and queried, never updated or
+// deleted, which a trigger enforces.
+type AuditLogRepo struct {
+	db *sql.DB
+}
+
+// NewAuditLogRepo creates a new AuditLogRepo instance.
+func NewAuditLogRepo(db *sql.DB) *AuditLogRepo {
+	return &AuditLogRepo{
+		db: db,
+	}
+}
+
+// AppendAuditEntry appends an entry to the audit log. The entry is stamped with the
+// current time unless it carries its own timestamp.
+func (r *AuditLogRepo) AppendAuditEntry(ctx context.Context, entry *health.AuditEntry) error {
+	timestamp := time.Now()
+	if entry.Timestamp != "" {
+		ts, err := storage.ParseTimestamp("timestamp", entry.Timestamp)
+		if err != nil {
+			return err
+		}
+		timestamp = ts
+	}
+
+	_, err := r.db.ExecContext(ctx, "INSERT INTO "+auditLog.name+" ("+auditLog.columns+")"+
+		" VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+		primitive.NewObjectID().Hex(), entry.Actor, textArray(entry.ActorRoles), entry.Action, entry.ResourceType,
+		entry.ResourceId, entry.UserId, entry.Outcome, entry.Source, entry.Method, entry.Error, timestamp)
+	if err != nil {
+		return errs.Wrap(err, "failed to append audit entry")
+	}
+	return nil
+}
+
+// QueryAuditLog returns a page of audit entries matching the filters, newest first.
+func (r *AuditLogRepo) QueryAuditLog(ctx context.Context, req *health.QueryAuditLogRequest) (*health.QueryAuditLogResponse, error) {
+	c := &conditions{}
+	if req.UserId != "" {
+		c.equal("user_id", req.UserId)
+	}
+	if req.Actor != "" {
+		c.equal("actor", req.Actor)
+	}
+	if req.ResourceType != "" {
+		c.equal("resource_type", req.ResourceType)
+	}
+	if req.ResourceId != "" {
+		c.equal("resource_id", req.ResourceId)
+	}
+	if err := addTimestampRange(c, "created_at", "", req.From, req.To); err != nil {
+		return nil, err
+	}
+
+	page, err := newPageRequest(req.PageSize, req.PageToken, "")
+	if err != nil {
+		return nil, err
+	}
+
+	entries, nextPageToken, totalSize, err := findPage(ctx, r.db, auditLog, c, page, scanAuditEntry)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to query audit log")
+	}
+
+	return &health.QueryAuditLogResponse{
+		Entries:       entries,
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}, nil
+}
+
+// scanAuditEntry reads an audit entry.
+func scanAuditEntry(_ context.Context, s scanner, extra ...interface{}) (*health.AuditEntry, error) {
+	var (
+		entry     = &health.AuditEntry{}
+		createdAt sql.NullTime
+	)
+	dest := append([]interface{}{&entry.Id, &entry.Actor, (*pq.StringArray)(&entry.ActorRoles), &entry.Action,
+		&entry.ResourceType, &entry.ResourceId, &entry.UserId, &entry.Outcome, &entry.Source, &entry.Method, &entry.Error,
+		&createdAt}, extra...)
+	if err := s.Scan(dest...); err != nil {
+		return nil, err
+	}
+	entry.Timestamp = formatTimestamp(createdAt)
+	if len(entry.ActorRoles) == 0 {
+		entry.ActorRoles = nil
+	}
+	return entry, nil
+}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/health-analytics-service/health-analytics-service/encryption"
+	"github.com/health-analytics-service/health-analytics-service/errs"
+)
+
+// DataKeyStore implements the encryption.KeyStore interface for PostgreSQL. Data keys
+// are stored wrapped in the data_keys table, one row per user and version.
+type DataKeyStore struct {
+	db *sql.DB
+}
+
+// NewDataKeyStore creates a new DataKeyStore instance.
+func NewDataKeyStore(db *sql.DB) *DataKeyStore {
+	return &DataKeyStore{
+		db: db,
+	}
+}
+
+const dataKeyColumns = "user_id, version, kek_id, wrapped_key, created_at"
+
+// dataKeyID identifies a data key in errors.
+func dataKeyID(userID string, version int) string {
+	return fmt.Sprintf("%s:%d", userID, version)
+}
+
+// scanDataKey reads a data key from dataKeyColumns.
+func scanDataKey(s scanner) (*encryption.DataKey, error) {
+	key := &encryption.DataKey{}
+	if err := s.Scan(&key.UserID, &key.Version, &key.KEKID, &key.WrappedKey, &key.CreatedAt); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// LatestDataKey returns the data key of the user with the highest version, or nil.
+func (s *DataKeyStore) LatestDataKey(ctx context.Context, userID string) (*encryption.DataKey, error) {
+	key, err := scanDataKey(s.db.QueryRowContext(ctx,
+		"SELECT "+dataKeyColumns+" FROM data_keys WHERE user_id = $1 ORDER BY version DESC LIMIT 1", userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errs.Wrap(err, "failed to get data key")
+	}
+	return key, nil
+}
+
+// GetDataKey returns a data key of the user by version.
+func (s *DataKeyStore) GetDataKey(ctx context.Context, userID string, version int) (*encryption.DataKey, error) {
+	key, err := scanDataKey(s.db.QueryRowContext(ctx,
+		"SELECT "+dataKeyColumns+" FROM data_keys WHERE user_id = $1 AND version = $2", userID, version))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.NotFound("data key", dataKeyID(userID, version))
+		}
+		return nil, errs.Wrap(err, "failed to get data key")
+	}
+	return key, nil
+}
+
+// InsertDataKey stores a new data key.
+func (s *DataKeyStore) InsertDataKey(ctx context.Context, key *encryption.DataKey) error {
+	_, err := s.db.ExecContext(ctx,
+		"INSERT INTO data_keys ("+dataKeyColumns+") VALUES ($1, $2, $3, $4, $5)",
+		key.UserID, key.Version, key.KEKID, key.WrappedKey, key.CreatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return errs.AlreadyExists("data key", dataKeyID(key.UserID, key.Version))
+		}
+		return errs.Wrap(err, "failed to insert data key")
+	}
+	return nil
+}
+
+// RewrapDataKey replaces the KEK id and wrapped key of a stored data key.
+func (s *DataKeyStore) RewrapDataKey(ctx context.Context, key *encryption.DataKey) error {
+	result, err := s.db.ExecContext(ctx,
+		"UPDATE data_keys SET kek_id = $3, wrapped_key = $4 WHERE user_id = $1 AND version = $2",
+		key.UserID, key.Version, key.KEKID, key.WrappedKey)
+	if err != nil {
+		return errs.Wrap(err, "failed to rewrap data key")
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return errs.Wrap(err, "failed to rewrap data key")
+	}
+	if updated == 0 {
+		return errs.NotFound("data key", dataKeyID(key.UserID, key.Version))
+	}
+	return nil
+}
+
+// ForEachDataKey calls fn for every stored data key.
+func (s *DataKeyStore) ForEachDataKey(ctx context.Context, fn func(*encryption.DataKey) error) error {
+	rows, err := s.db.QueryContext(ctx, "SELECT "+dataKeyColumns+" FROM data_keys ORDER BY user_id, version")
+	if err != nil {
+		return errs.Wrap(err, "failed to find data keys")
+	}
+	defer rows.Close()
+
+	// Read all keys first, so that fn can use the store while iterating
+	var keys []*encryption.DataKey
+	for rows.Next() {
+		key, err := scanDataKey(rows)
+		if err != nil {
+			return errs.Wrap(err, "failed to decode data key")
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return errs.Wrap(err, "failed to find data keys")
+	}
+	rows.Close()
+
+	for _, key := range keys {
+		if err := fn(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
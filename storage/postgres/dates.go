@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/storage"
+)
+
+// dateValue converts a calendar date to its stored form, NULL when empty.
+func dateValue(field, value string) (interface{}, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := storage.ParseDate(field, value)
+	if err != nil {
+		return nil, err
+	}
+	return date.Format(storage.DateLayout), nil
+}
+
+// timestampValue converts a timestamp to its stored form, NULL when empty.
+func timestampValue(field, value string) (interface{}, error) {
+	if value == "" {
+		return nil, nil
+	}
+	return storage.ParseTimestamp(field, value)
+}
+
+// formatDate renders a stored calendar date.
+func formatDate(value sql.NullTime) string {
+	if !value.Valid {
+		return ""
+	}
+	return value.Time.Format(storage.DateLayout)
+}
+
+// formatTimestamp renders a stored timestamp in UTC.
+func formatTimestamp(value sql.NullTime) string {
+	if !value.Valid {
+		return ""
+	}
+	return value.Time.UTC().Format(storage.TimestampLayout)
+}
+
+// formatTime renders the creation or update time of an entity.
+func formatTime(value time.Time) string {
+	return value.Local().Format(time.RFC3339)
+}
+
+// addDateRange adds an inclusive calendar date range (and optional exact date) on
+// column to the conditions.
+func addDateRange(c *conditions, column, exact, from, to string) error {
+	if exact != "" {
+		date, err := storage.ParseDate(column, exact)
+		if err != nil {
+			return err
+		}
+		c.add(column + " = " + c.arg(date.Format(storage.DateLayout)))
+	}
+	if from != "" {
+		date, err := storage.ParseDate(column+"_from", from)
+		if err != nil {
+			return err
+		}
+		c.add(column + " >= " + c.arg(date.Format(storage.DateLayout)))
+	}
+	if to != "" {
+		date, err := storage.ParseDate(column+"_to", to)
+		if err != nil {
+			return err
+		}
+		c.add(column + " <= " + c.arg(date.Format(storage.DateLayout)))
+	}
+	return nil
+}
+
+// addTimestampRange adds a half-open [from, to) timestamp range (and optional exact
+// timestamp) on column to the conditions.
+func addTimestampRange(c *conditions, column, exact, from, to string) error {
+	if exact != "" {
+		ts, err := storage.ParseTimestamp(column, exact)
+		if err != nil {
+			return err
+		}
+		c.add(column + " = " + c.arg(ts))
+	}
+	if from != "" {
+		ts, err := storage.ParseTimestamp(column+"_from", from)
+		if err != nil {
+			return err
+		}
+		c.add(column + " >= " + c.arg(ts))
+	}
+	if to != "" {
+		ts, err := storage.ParseTimestamp(column+"_to", to)
+		if err != nil {
+			return err
+		}
+		c.add(column + " < " + c.arg(ts))
+	}
+	return nil
+}
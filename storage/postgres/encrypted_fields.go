@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/health-analytics-service/health-analytics-service/encryption"
+	"github.com/health-analytics-service/health-analytics-service/errs"
+)
+
+// Encrypted fields, named as in the MongoDB storage since they are bound to the
+// ciphertexts. Medical record descriptions also get a blind index, so that they can
+// still be filtered on by exact value.
+const (
+	descriptionField = "description"
+	attachmentsField = "attachments"
+	dataValueField   = "data_value"
+)
+
+// encryptDescription sets the encrypted description of a medical record owned by
+// userID, along with its blind index.
+func encryptDescription(ctx context.Context, cipher *encryption.Cipher, userID, description string, values *assignments) error {
+	encrypted, err := cipher.Encrypt(ctx, userID, descriptionField, description)
+	if err != nil {
+		return errs.Wrap(err, "failed to encrypt description")
+	}
+	values.set("description", encrypted)
+	values.set("description_bidx", cipher.BlindIndex(descriptionField, description))
+	return nil
+}
+
+// encryptAttachments sets the encrypted attachments of a medical record owned by userID.
+func encryptAttachments(ctx context.Context, cipher *encryption.Cipher, userID string, attachments []string, values *assignments) error {
+	encrypted := make([]string, len(attachments))
+	for i, attachment := range attachments {
+		var err error
+		if encrypted[i], err = cipher.Encrypt(ctx, userID, attachmentsField, attachment); err != nil {
+			return errs.Wrap(err, "failed to encrypt attachment")
+		}
+	}
+	values.set("attachments", textArray(encrypted))
+	return nil
+}
@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/encryption"
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// geneticData is the table of genetic data.
+var geneticData = table{
+	name:     "genetic_data",
+	resource: "genetic data",
+	columns:  "id, user_id, data_type, data_value, analysis_date, created_at, updated_at, version",
+}
+
+// GeneticDataRepo implements the storage.GeneticDataRepoI interface for PostgreSQL.
+// Data values are stored encrypted with the data keys of the user.
+type GeneticDataRepo struct {
+	db     *sql.DB
+	cipher *encryption.Cipher
+}
+
+// NewGeneticDataRepo creates a new GeneticDataRepo instance.
+func NewGeneticDataRepo(db *sql.DB, cipher *encryption.Cipher) *GeneticDataRepo {
+	return &GeneticDataRepo{
+		db:     db,
+		cipher: cipher,
+	}
+}
+
+// CreateGeneticData creates a new genetic data record in the database.
+func (r *GeneticDataRepo) CreateGeneticData(ctx context.Context, data *health.GeneticData) (*health.GeneticData, error) {
+	id, err := newID(geneticData.resource, data.Id)
+	if err != nil {
+		return nil, err
+	}
+	dataValue, err := r.encryptDataValue(ctx, data.UserId, data.DataValue)
+	if err != nil {
+		return nil, err
+	}
+	analysisDate, err := dateValue("analysis_date", data.AnalysisDate)
+	if err != nil {
+		return nil, err
+	}
+
+	values := &assignments{}
+	values.set("user_id", data.UserId)
+	values.set("data_type", data.DataType)
+	values.set("data_value", dataValue)
+	values.set("analysis_date", analysisDate)
+
+	return insert(ctx, r.db, geneticData, id, values, r.scan)
+}
+
+// GetGeneticData retrieves a genetic data record by its ID.
+func (r *GeneticDataRepo) GetGeneticData(ctx context.Context, id string) (*health.GeneticData, error) {
+	id, err := parseID(geneticData.resource, id)
+	if err != nil {
+		return nil, err
+	}
+	return getByID(ctx, r.db, geneticData, id, r.scan)
+}
+
+// UpdateGeneticData updates an existing genetic data record in the database. With an
+// update_mask, only the masked fields are written.
+func (r *GeneticDataRepo) UpdateGeneticData(ctx context.Context, data *health.GeneticData) (*health.GeneticData, error) {
+	id, err := parseID(geneticData.resource, data.Id)
+	if err != nil {
+		return nil, err
+	}
+	masked := func(field string) bool { return storage.MaskIncludes(data.UpdateMask, field) }
+
+	var analysisDate interface{}
+	if masked("analysis_date") {
+		if analysisDate, err = dateValue("analysis_date", data.AnalysisDate); err != nil {
+			return nil, err
+		}
+	}
+
+	return updateVersioned(ctx, r.db, geneticData, id, data.ExpectedVersion,
+		func(_ *sql.Tx, owner string, _ int64) (*assignments, error) {
+			values := &assignments{}
+			if masked("user_id") {
+				values.set("user_id", data.UserId)
+				owner = data.UserId // Encrypt with the keys of the owner after the update
+			}
+			if masked("data_type") {
+				values.set("data_type", data.DataType)
+			}
+			if masked("data_value") {
+				dataValue, err := r.encryptDataValue(ctx, owner, data.DataValue)
+				if err != nil {
+					return nil, err
+				}
+				values.set("data_value", dataValue)
+			}
+			if masked("analysis_date") {
+				values.set("analysis_date", analysisDate)
+			}
+			return values, nil
+		}, r.scan)
+}
+
+// DeleteGeneticData soft-deletes a genetic data record. It is excluded from all reads and can be
+// restored until it is purged by the retention job.
+func (r *GeneticDataRepo) DeleteGeneticData(ctx context.Context, id string) error {
+	id, err := parseID(geneticData.resource, id)
+	if err != nil {
+		return err
+	}
+	return softDelete(ctx, r.db, geneticData, id)
+}
+
+// RestoreGeneticData restores a genetic data record that was soft-deleted and not purged yet.
+func (r *GeneticDataRepo) RestoreGeneticData(ctx context.Context, id string) (*health.GeneticData, error) {
+	id, err := parseID(geneticData.resource, id)
+	if err != nil {
+		return nil, err
+	}
+	return restore(ctx, r.db, geneticData, id, r.scan)
+}
+
+// ListGeneticData retrieves all genetic data records for a given user ID, applying filters if provided.
+func (r *GeneticDataRepo) ListGeneticData(ctx context.Context, req *health.ListGeneticDataRequest) (*health.ListGeneticDataResponse, error) {
+	if err := storage.RejectPayloadFilters(req.DataValueFilters); err != nil {
+		return nil, err
+	}
+
+	// Build the conditions based on the request parameters
+	c := (&conditions{}).notDeleted()
+	if req.UserId != "" {
+		c.equal("user_id", req.UserId)
+	}
+	if req.DataType != "" {
+		c.equal("data_type", req.DataType)
+	}
+	if err := addDateRange(c, "analysis_date", req.AnalysisDate, req.AnalysisDateFrom, req.AnalysisDateTo); err != nil {
+		return nil, err
+	}
+
+	page, err := newPageRequest(req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	data, nextPageToken, totalSize, err := findPage(ctx, r.db, geneticData, c, page, r.scan)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list genetic data")
+	}
+
+	return &health.ListGeneticDataResponse{
+		GeneticData:   data,
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}, nil
+}
+
+// encryptDataValue marshals the data_value of genetic data to JSON, encrypts it with
+// the data key of its user and returns the ciphertext as a JSON string.
+func (r *GeneticDataRepo) encryptDataValue(ctx context.Context, userID string, dataValue *anypb.Any) (string, error) {
+	dataVal, err := protojson.Marshal(dataValue)
+	if err != nil {
+		return "", err
+	}
+	encrypted, err := r.cipher.Encrypt(ctx, userID, dataValueField, string(dataVal))
+	if err != nil {
+		return "", errs.Wrap(err, "failed to encrypt data_value")
+	}
+	stored, err := json.Marshal(encrypted)
+	if err != nil {
+		return "", err
+	}
+	return string(stored), nil
+}
+
+// scan reads genetic data and decrypts its data value.
+func (r *GeneticDataRepo) scan(ctx context.Context, s scanner, extra ...interface{}) (*health.GeneticData, error) {
+	var (
+		data                 = &health.GeneticData{}
+		dataValue            []byte
+		analysisDate         sql.NullTime
+		createdAt, updatedAt time.Time
+	)
+	dest := append([]interface{}{&data.Id, &data.UserId, &data.DataType, &dataValue, &analysisDate,
+		&createdAt, &updatedAt, &data.Version}, extra...)
+	if err := s.Scan(dest...); err != nil {
+		return nil, err
+	}
+	data.AnalysisDate = formatDate(analysisDate)
+	data.CreatedAt = formatTime(createdAt)
+	data.UpdatedAt = formatTime(updatedAt)
+
+	if dataValue != nil {
+		var encrypted string
+		if err := json.Unmarshal(dataValue, &encrypted); err != nil {
+			return nil, errs.Wrap(err, "failed to unmarshal data_value")
+		}
+		plain, err := r.cipher.Decrypt(ctx, dataValueField, encrypted)
+		if err != nil {
+			return nil, errs.Wrap(err, "failed to decrypt data_value")
+		}
+		data.DataValue = &anypb.Any{}
+		if err := protojson.Unmarshal([]byte(plain), data.DataValue); err != nil {
+			return nil, errs.Wrap(err, "failed to unmarshal data_value from JSON")
+		}
+	}
+
+	return data, nil
+}
@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+)
+
+// healthRecommendations is the table of health recommendations.
+var healthRecommendations = table{
+	name:     "health_recommendations",
+	resource: "health recommendation",
+	columns:  "id, user_id, recommendation_type, description, priority, created_at, updated_at, version",
+}
+
+// HealthRecommendationRepo implements the storage.HealthRecommendationRepoI interface for PostgreSQL.
+type HealthRecommendationRepo struct {
+	db *sql.DB
+}
+
+// NewHealthRecommendationRepo creates a new HealthRecommendationRepo instance.
+func NewHealthRecommendationRepo(db *sql.DB) *HealthRecommendationRepo {
+	return &HealthRecommendationRepo{
+		db: db,
+	}
+}
+
+// CreateHealthRecommendation creates a new health recommendation in the database.
+func (r *HealthRecommendationRepo) CreateHealthRecommendation(ctx context.Context, recommendation *health.HealthRecommendation) (*health.HealthRecommendation, error) {
+	id, err := newID(healthRecommendations.resource, recommendation.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	values := &assignments{}
+	values.set("user_id", recommendation.UserId)
+	values.set("recommendation_type", recommendation.RecommendationType)
+	values.set("description", recommendation.Description)
+	values.set("priority", recommendation.Priority)
+
+	return insert(ctx, r.db, healthRecommendations, id, values, r.scan)
+}
+
+// GetHealthRecommendation retrieves a health recommendation by its ID.
+func (r *HealthRecommendationRepo) GetHealthRecommendation(ctx context.Context, id string) (*health.HealthRecommendation, error) {
+	id, err := parseID(healthRecommendations.resource, id)
+	if err != nil {
+		return nil, err
+	}
+	return getByID(ctx, r.db, healthRecommendations, id, r.scan)
+}
+
+// UpdateHealthRecommendation updates an existing health recommendation in the database.
+// With an update_mask, only the masked fields are written.
+func (r *HealthRecommendationRepo) UpdateHealthRecommendation(ctx context.Context, recommendation *health.HealthRecommendation) (*health.HealthRecommendation, error) {
+	id, err := parseID(healthRecommendations.resource, recommendation.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	values := &assignments{}
+	for _, field := range []struct {
+		column string
+		value  interface{}
+	}{
+		{"user_id", recommendation.UserId},
+		{"recommendation_type", recommendation.RecommendationType},
+		{"description", recommendation.Description},
+		{"priority", recommendation.Priority},
+	} {
+		if storage.MaskIncludes(recommendation.UpdateMask, field.column) {
+			values.set(field.column, field.value)
+		}
+	}
+
+	return updateVersioned(ctx, r.db, healthRecommendations, id, recommendation.ExpectedVersion,
+		func(*sql.Tx, string, int64) (*assignments, error) {
+			return values, nil
+		}, r.scan)
+}
+
+// DeleteHealthRecommendation soft-deletes a health recommendation. It is excluded from all reads and can be
+// restored until it is purged by the retention job.
+func (r *HealthRecommendationRepo) DeleteHealthRecommendation(ctx context.Context, id string) error {
+	id, err := parseID(healthRecommendations.resource, id)
+	if err != nil {
+		return err
+	}
+	return softDelete(ctx, r.db, healthRecommendations, id)
+}
+
+// RestoreHealthRecommendation restores a health recommendation that was soft-deleted and not purged yet.
+func (r *HealthRecommendationRepo) RestoreHealthRecommendation(ctx context.Context, id string) (*health.HealthRecommendation, error) {
+	id, err := parseID(healthRecommendations.resource, id)
+	if err != nil {
+		return nil, err
+	}
+	return restore(ctx, r.db, healthRecommendations, id, r.scan)
+}
+
+// ListHealthRecommendations retrieves all health recommendations for a given user ID, applying filters if provided.
+func (r *HealthRecommendationRepo) ListHealthRecommendations(ctx context.Context, req *health.ListHealthRecommendationsRequest) (*health.ListHealthRecommendationsResponse, error) {
+	// Build the conditions based on the request parameters
+	c := (&conditions{}).notDeleted()
+	if req.UserId != "" {
+		c.equal("user_id", req.UserId)
+	}
+	if req.RecommendationType != "" {
+		c.equal("recommendation_type", req.RecommendationType)
+	}
+	if req.Priority != 0 {
+		c.equal("priority", req.Priority)
+	}
+
+	page, err := newPageRequest(req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendations, nextPageToken, totalSize, err := findPage(ctx, r.db, healthRecommendations, c, page, r.scan)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list health recommendations")
+	}
+
+	return &health.ListHealthRecommendationsResponse{
+		HealthRecommendations: recommendations,
+		NextPageToken:         nextPageToken,
+		TotalSize:             totalSize,
+	}, nil
+}
+
+// scan reads a health recommendation.
+func (r *HealthRecommendationRepo) scan(_ context.Context, s scanner, extra ...interface{}) (*health.HealthRecommendation, error) {
+	var (
+		recommendation       = &health.HealthRecommendation{}
+		createdAt, updatedAt time.Time
+	)
+	dest := append([]interface{}{&recommendation.Id, &recommendation.UserId, &recommendation.RecommendationType,
+		&recommendation.Description, &recommendation.Priority, &createdAt, &updatedAt, &recommendation.Version}, extra...)
+	if err := s.Scan(dest...); err != nil {
+		return nil, err
+	}
+	recommendation.CreatedAt = formatTime(createdAt)
+	recommendation.UpdatedAt = formatTime(updatedAt)
+	return recommendation, nil
+}
@@ -0,0 +1,177 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+)
+
+// lifestyleData is the table of lifestyle data.
+var lifestyleData = table{
+	name:     "lifestyle_data",
+	resource: "lifestyle data",
+	columns:  "id, user_id, data_type, data_value, recorded_date, created_at, updated_at, version",
+}
+
+// LifestyleDataRepo implements the storage.LifestyleDataRepoI interface for PostgreSQL.
+type LifestyleDataRepo struct {
+	db *sql.DB
+}
+
+// NewLifestyleDataRepo creates a new LifestyleDataRepo instance.
+func NewLifestyleDataRepo(db *sql.DB) *LifestyleDataRepo {
+	return &LifestyleDataRepo{
+		db: db,
+	}
+}
+
+// CreateLifestyleData creates a new lifestyle data record in the database.
+func (r *LifestyleDataRepo) CreateLifestyleData(ctx context.Context, data *health.LifestyleData) (*health.LifestyleData, error) {
+	id, err := newID(lifestyleData.resource, data.Id)
+	if err != nil {
+		return nil, err
+	}
+	dataValue, err := dataValueJSON(data.DataValue)
+	if err != nil {
+		return nil, err
+	}
+	recordedDate, err := dateValue("recorded_date", data.RecordedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	values := &assignments{}
+	values.set("user_id", data.UserId)
+	values.set("data_type", data.DataType)
+	values.set("data_value", dataValue)
+	values.set("recorded_date", recordedDate)
+
+	return insert(ctx, r.db, lifestyleData, id, values, r.scan)
+}
+
+// GetLifestyleData retrieves a lifestyle data record by its ID.
+func (r *LifestyleDataRepo) GetLifestyleData(ctx context.Context, id string) (*health.LifestyleData, error) {
+	id, err := parseID(lifestyleData.resource, id)
+	if err != nil {
+		return nil, err
+	}
+	return getByID(ctx, r.db, lifestyleData, id, r.scan)
+}
+
+// UpdateLifestyleData updates an existing lifestyle data record in the database. With an
+// update_mask, only the masked fields are written.
+func (r *LifestyleDataRepo) UpdateLifestyleData(ctx context.Context, data *health.LifestyleData) (*health.LifestyleData, error) {
+	id, err := parseID(lifestyleData.resource, data.Id)
+	if err != nil {
+		return nil, err
+	}
+	masked := func(field string) bool { return storage.MaskIncludes(data.UpdateMask, field) }
+
+	values := &assignments{}
+	if masked("user_id") {
+		values.set("user_id", data.UserId)
+	}
+	if masked("data_type") {
+		values.set("data_type", data.DataType)
+	}
+	if masked("data_value") {
+		dataValue, err := dataValueJSON(data.DataValue)
+		if err != nil {
+			return nil, err
+		}
+		values.set("data_value", dataValue)
+	}
+	if masked("recorded_date") {
+		recordedDate, err := dateValue("recorded_date", data.RecordedDate)
+		if err != nil {
+			return nil, err
+		}
+		values.set("recorded_date", recordedDate)
+	}
+
+	return updateVersioned(ctx, r.db, lifestyleData, id, data.ExpectedVersion,
+		func(*sql.Tx, string, int64) (*assignments, error) {
+			return values, nil
+		}, r.scan)
+}
+
+// DeleteLifestyleData soft-deletes a lifestyle data record. It is excluded from all reads and can be
+// restored until it is purged by the retention job.
+func (r *LifestyleDataRepo) DeleteLifestyleData(ctx context.Context, id string) error {
+	id, err := parseID(lifestyleData.resource, id)
+	if err != nil {
+		return err
+	}
+	return softDelete(ctx, r.db, lifestyleData, id)
+}
+
+// RestoreLifestyleData restores a lifestyle data record that was soft-deleted and not purged yet.
+func (r *LifestyleDataRepo) RestoreLifestyleData(ctx context.Context, id string) (*health.LifestyleData, error) {
+	id, err := parseID(lifestyleData.resource, id)
+	if err != nil {
+		return nil, err
+	}
+	return restore(ctx, r.db, lifestyleData, id, r.scan)
+}
+
+// ListLifestyleData retrieves all lifestyle data records for a given user ID, applying filters if provided.
+func (r *LifestyleDataRepo) ListLifestyleData(ctx context.Context, req *health.ListLifestyleDataRequest) (*health.ListLifestyleDataResponse, error) {
+	// Build the conditions based on the request parameters
+	c := (&conditions{}).notDeleted()
+	if req.UserId != "" {
+		c.equal("user_id", req.UserId)
+	}
+	if req.DataType != "" {
+		c.equal("data_type", req.DataType)
+	}
+	if err := addDateRange(c, "recorded_date", req.RecordedDate, req.RecordedDateFrom, req.RecordedDateTo); err != nil {
+		return nil, err
+	}
+	if err := addPayloadFilters(c, req.DataValueFilters); err != nil {
+		return nil, err
+	}
+
+	page, err := newPageRequest(req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	data, nextPageToken, totalSize, err := findPage(ctx, r.db, lifestyleData, c, page, r.scan)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list lifestyle data")
+	}
+
+	return &health.ListLifestyleDataResponse{
+		LifestyleData: data,
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}, nil
+}
+
+// scan reads lifestyle data.
+func (r *LifestyleDataRepo) scan(_ context.Context, s scanner, extra ...interface{}) (*health.LifestyleData, error) {
+	var (
+		data                 = &health.LifestyleData{}
+		dataValue            []byte
+		recordedDate         sql.NullTime
+		createdAt, updatedAt time.Time
+	)
+	dest := append([]interface{}{&data.Id, &data.UserId, &data.DataType, &dataValue, &recordedDate,
+		&createdAt, &updatedAt, &data.Version}, extra...)
+	if err := s.Scan(dest...); err != nil {
+		return nil, err
+	}
+	data.RecordedDate = formatDate(recordedDate)
+	data.CreatedAt = formatTime(createdAt)
+	data.UpdatedAt = formatTime(updatedAt)
+
+	var err error
+	if data.DataValue, err = parseDataValue(dataValue); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
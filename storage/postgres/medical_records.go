@@ -0,0 +1,323 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/encryption"
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"github.com/lib/pq"
+)
+
+// medicalRecords is the table of medical records.
+var medicalRecords = table{
+	name:     "medical_records",
+	resource: "medical record",
+	columns:  "id, user_id, record_type, record_date, description, doctor_id, attachments, created_at, updated_at, version",
+}
+
+// medicalRecordVersions is the table of prior revisions, read by the same scanner.
+var medicalRecordVersions = table{
+	name:     "medical_records_history",
+	resource: "medical record version",
+	columns:  "record_id, user_id, record_type, record_date, description, doctor_id, attachments, created_at, updated_at, version",
+}
+
+// MedicalRecordRepo implements the storage.MedicalRecordRepoI interface for PostgreSQL.
+// Descriptions and attachments are stored encrypted with the data keys of the user.
+type MedicalRecordRepo struct {
+	db     *sql.DB
+	cipher *encryption.Cipher
+}
+
+// NewMedicalRecordRepo creates a new MedicalRecordRepo instance.
+func NewMedicalRecordRepo(db *sql.DB, cipher *encryption.Cipher) *MedicalRecordRepo {
+	return &MedicalRecordRepo{
+		db:     db,
+		cipher: cipher,
+	}
+}
+
+// CreateMedicalRecord creates a new medical record in the database.
+func (r *MedicalRecordRepo) CreateMedicalRecord(ctx context.Context, record *health.MedicalRecord) (*health.MedicalRecord, error) {
+	id, err := newID(medicalRecords.resource, record.Id)
+	if err != nil {
+		return nil, err
+	}
+	recordDate, err := dateValue("record_date", record.RecordDate)
+	if err != nil {
+		return nil, err
+	}
+
+	values := &assignments{}
+	values.set("user_id", record.UserId)
+	values.set("record_type", record.RecordType)
+	values.set("record_date", recordDate)
+	values.set("doctor_id", record.DoctorId)
+	if err := encryptDescription(ctx, r.cipher, record.UserId, record.Description, values); err != nil {
+		return nil, err
+	}
+	if err := encryptAttachments(ctx, r.cipher, record.UserId, record.Attachments, values); err != nil {
+		return nil, err
+	}
+
+	return insert(ctx, r.db, medicalRecords, id, values, r.scan)
+}
+
+// GetMedicalRecord retrieves a medical record by its ID.
+func (r *MedicalRecordRepo) GetMedicalRecord(ctx context.Context, id string) (*health.MedicalRecord, error) {
+	id, err := parseID(medicalRecords.resource, id)
+	if err != nil {
+		return nil, err
+	}
+	return getByID(ctx, r.db, medicalRecords, id, r.scan)
+}
+
+// UpdateMedicalRecord updates an existing medical record in the database. The previous
+// revision is archived to the medical_records_history table and the version is
+// incremented. When record.ExpectedVersion is set, the update fails with
+// FailedPrecondition unless the record is still at that version. With an update_mask,
+// only the masked fields are written, empty or not; otherwise only non-empty fields are.
+func (r *MedicalRecordRepo) UpdateMedicalRecord(ctx context.Context, record *health.MedicalRecord) (*health.MedicalRecord, error) {
+	id, err := parseID(medicalRecords.resource, record.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without an update_mask, the fields that are set are written
+	written := func(field string, set bool) bool {
+		if len(record.UpdateMask.GetPaths()) == 0 {
+			return set
+		}
+		return storage.MaskIncludes(record.UpdateMask, field)
+	}
+
+	var recordDate interface{}
+	if written("record_date", record.RecordDate != "") {
+		if recordDate, err = dateValue("record_date", record.RecordDate); err != nil {
+			return nil, err
+		}
+	}
+
+	return updateVersioned(ctx, r.db, medicalRecords, id, record.ExpectedVersion,
+		func(tx *sql.Tx, owner string, version int64) (*assignments, error) {
+			values := &assignments{}
+			if written("user_id", record.UserId != "") {
+				values.set("user_id", record.UserId)
+				owner = record.UserId // Encrypt with the keys of the owner after the update
+			}
+			if written("record_type", record.RecordType != "") {
+				values.set("record_type", record.RecordType)
+			}
+			if written("record_date", record.RecordDate != "") {
+				values.set("record_date", recordDate)
+			}
+			if written("doctor_id", record.DoctorId != "") {
+				values.set("doctor_id", record.DoctorId)
+			}
+			if written("description", record.Description != "") {
+				if err := encryptDescription(ctx, r.cipher, owner, record.Description, values); err != nil {
+					return nil, err
+				}
+			}
+			if written("attachments", len(record.Attachments) > 0) {
+				if err := encryptAttachments(ctx, r.cipher, owner, record.Attachments, values); err != nil {
+					return nil, err
+				}
+			}
+
+			// Archive the current revision before it is overwritten
+			if err := r.archive(ctx, tx, id); err != nil {
+				return nil, err
+			}
+			return values, nil
+		}, r.scan)
+}
+
+// archive copies the current revision of a medical record to the history.
+func (r *MedicalRecordRepo) archive(ctx context.Context, tx *sql.Tx, id string) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO medical_records_history
+		(record_id, version, user_id, record_type, record_date, description, description_bidx, doctor_id, attachments,
+		 created_at, updated_at, archived_by)
+		SELECT id, version, user_id, record_type, record_date, description, description_bidx, doctor_id, attachments,
+		 created_at, updated_at, $2
+		FROM medical_records WHERE id = $1
+		ON CONFLICT (record_id, version) DO NOTHING`,
+		id, storage.ActorFromContext(ctx))
+	if err != nil {
+		return errs.Wrap(err, "failed to archive medical record")
+	}
+	return nil
+}
+
+// ListMedicalRecordVersions retrieves the prior revisions of a medical record, newest first.
+func (r *MedicalRecordRepo) ListMedicalRecordVersions(ctx context.Context, req *health.ListMedicalRecordVersionsRequest) (*health.ListMedicalRecordVersionsResponse, error) {
+	// The history of deleted records is hidden along with them
+	current, err := r.GetMedicalRecord(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := storage.PageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	c := &conditions{}
+	c.equal("record_id", current.Id)
+	response := &health.ListMedicalRecordVersionsResponse{}
+	if err := r.db.QueryRowContext(ctx, "SELECT count(*) FROM "+medicalRecordVersions.name+c.where(), c.args...).Scan(&response.TotalSize); err != nil {
+		return nil, errs.Wrap(err, "failed to count medical record versions")
+	}
+	if req.PageToken != "" {
+		before, err := storage.ParseVersionPageToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		c.add("version < " + c.arg(before))
+	}
+
+	// Fetch one extra revision to find out whether there is a next page
+	rows, err := r.db.QueryContext(ctx, "SELECT "+medicalRecordVersions.columns+" FROM "+medicalRecordVersions.name+c.where()+
+		fmt.Sprintf(" ORDER BY version DESC LIMIT %d", size+1), c.args...)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list medical record versions")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if int64(len(response.Versions)) == size {
+			response.NextPageToken = storage.VersionPageToken(response.Versions[len(response.Versions)-1].Version)
+			break
+		}
+		revision, err := r.scan(ctx, rows)
+		if err != nil {
+			return nil, errs.Wrap(err, "failed to decode medical record version")
+		}
+		response.Versions = append(response.Versions, revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errs.Wrap(err, "failed to list medical record versions")
+	}
+
+	return response, nil
+}
+
+// GetMedicalRecordVersion retrieves a revision of a medical record, either the
+// current one or a prior one from the history.
+func (r *MedicalRecordRepo) GetMedicalRecordVersion(ctx context.Context, id string, version int64) (*health.MedicalRecord, error) {
+	if version < 1 {
+		return nil, errs.InvalidArgument("version", "version must be positive")
+	}
+	current, err := r.GetMedicalRecord(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if version == current.Version {
+		return current, nil
+	}
+
+	revision, err := r.scan(ctx, r.db.QueryRowContext(ctx,
+		"SELECT "+medicalRecordVersions.columns+" FROM "+medicalRecordVersions.name+" WHERE record_id = $1 AND version = $2",
+		current.Id, version))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.NotFound("medical record version", fmt.Sprintf("%s@%d", id, version))
+		}
+		return nil, errs.Wrap(err, "failed to get medical record version")
+	}
+	return revision, nil
+}
+
+// DeleteMedicalRecord soft-deletes a medical record. It is excluded from all reads and can be
+// restored until it is purged by the retention job.
+func (r *MedicalRecordRepo) DeleteMedicalRecord(ctx context.Context, id string) error {
+	id, err := parseID(medicalRecords.resource, id)
+	if err != nil {
+		return err
+	}
+	return softDelete(ctx, r.db, medicalRecords, id)
+}
+
+// RestoreMedicalRecord restores a medical record that was soft-deleted and not purged yet.
+func (r *MedicalRecordRepo) RestoreMedicalRecord(ctx context.Context, id string) (*health.MedicalRecord, error) {
+	id, err := parseID(medicalRecords.resource, id)
+	if err != nil {
+		return nil, err
+	}
+	return restore(ctx, r.db, medicalRecords, id, r.scan)
+}
+
+// ListMedicalRecords retrieves all medical records for a given user ID, applying filters if provided.
+func (r *MedicalRecordRepo) ListMedicalRecords(ctx context.Context, req *health.ListMedicalRecordsRequest) (*health.ListMedicalRecordsResponse, error) {
+	// Build the conditions based on the request parameters
+	c := (&conditions{}).notDeleted()
+	if req.UserId != "" {
+		c.equal("user_id", req.UserId)
+	}
+	if req.RecordType != "" {
+		c.equal("record_type", req.RecordType)
+	}
+	if err := addDateRange(c, "record_date", req.RecordDate, req.RecordDateFrom, req.RecordDateTo); err != nil {
+		return nil, err
+	}
+	if req.Description != "" {
+		c.equal("description_bidx", r.cipher.BlindIndex(descriptionField, req.Description))
+	}
+	if req.DoctorId != "" {
+		c.equal("doctor_id", req.DoctorId)
+	}
+
+	page, err := newPageRequest(req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	records, nextPageToken, totalSize, err := findPage(ctx, r.db, medicalRecords, c, page, r.scan)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list medical records")
+	}
+
+	return &health.ListMedicalRecordsResponse{
+		MedicalRecords: records,
+		NextPageToken:  nextPageToken,
+		TotalSize:      totalSize,
+	}, nil
+}
+
+// scan reads a medical record (or a prior revision) and decrypts its description and
+// attachments.
+func (r *MedicalRecordRepo) scan(ctx context.Context, s scanner, extra ...interface{}) (*health.MedicalRecord, error) {
+	var (
+		record               = &health.MedicalRecord{}
+		recordDate           sql.NullTime
+		attachments          []string
+		createdAt, updatedAt time.Time
+	)
+	dest := append([]interface{}{&record.Id, &record.UserId, &record.RecordType, &recordDate, &record.Description,
+		&record.DoctorId, (*pq.StringArray)(&attachments), &createdAt, &updatedAt, &record.Version}, extra...)
+	if err := s.Scan(dest...); err != nil {
+		return nil, err
+	}
+	record.RecordDate = formatDate(recordDate)
+	record.CreatedAt = formatTime(createdAt)
+	record.UpdatedAt = formatTime(updatedAt)
+
+	var err error
+	if record.Description, err = r.cipher.Decrypt(ctx, descriptionField, record.Description); err != nil {
+		return nil, errs.Wrap(err, "failed to decrypt description")
+	}
+	for _, attachment := range attachments {
+		plain, err := r.cipher.Decrypt(ctx, attachmentsField, attachment)
+		if err != nil {
+			return nil, errs.Wrap(err, "failed to decrypt attachment")
+		}
+		record.Attachments = append(record.Attachments, plain)
+	}
+
+	return record, nil
+}
@@ -0,0 +1,287 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/health-analytics-service/health-analytics-service/encryption"
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"github.com/lib/pq"
+)
+
+// HealthMonitoringRepo implements the storage.HealthMonitoringRepoI interface for PostgreSQL.
+type HealthMonitoringRepo struct {
+	db *sql.DB
+
+	// Repositories reading the raw records of summaries
+	medicalRecordRepo        *MedicalRecordRepo
+	geneticDataRepo          *GeneticDataRepo
+	lifestyleDataRepo        *LifestyleDataRepo
+	wearableDataRepo         *WearableDataRepo
+	healthRecommendationRepo *HealthRecommendationRepo
+}
+
+// NewHealthMonitoringRepo creates a new HealthMonitoringRepo instance.
+func NewHealthMonitoringRepo(db *sql.DB, cipher *encryption.Cipher) *HealthMonitoringRepo {
+	return &HealthMonitoringRepo{
+		db:                       db,
+		medicalRecordRepo:        NewMedicalRecordRepo(db, cipher),
+		geneticDataRepo:          NewGeneticDataRepo(db, cipher),
+		lifestyleDataRepo:        NewLifestyleDataRepo(db),
+		wearableDataRepo:         NewWearableDataRepo(db),
+		healthRecommendationRepo: NewHealthRecommendationRepo(db),
+	}
+}
+
+// GetDailySummary retrieves a daily summary of health data for a given user ID and date.
+// The day is interpreted as a calendar day in the requested time zone (UTC by default)
+// and defaults to the current day when no date is given.
+func (r *HealthMonitoringRepo) GetDailySummary(ctx context.Context, req *health.DailySummaryRequest) (*health.SummaryResponse, error) {
+	window, err := storage.DailySummaryWindow(req)
+	if err != nil {
+		return nil, err
+	}
+	return r.getSummary(ctx, req.UserId, window)
+}
+
+// GetWeeklySummary retrieves a weekly summary of health data for a given user ID and date range.
+// Both dates are inclusive calendar days in the requested time zone (UTC by default).
+func (r *HealthMonitoringRepo) GetWeeklySummary(ctx context.Context, req *health.WeeklySummaryRequest) (*health.SummaryResponse, error) {
+	window, err := storage.WeeklySummaryWindow(req)
+	if err != nil {
+		return nil, err
+	}
+	return r.getSummary(ctx, req.UserId, window)
+}
+
+// summaryTables lists the tables covered by summaries along with the column their
+// rows are grouped by in type counts.
+var summaryTables = []struct {
+	name       string
+	typeColumn string
+}{
+	{name: "medical_records", typeColumn: "record_type"},
+	{name: "genetic_data", typeColumn: "data_type"},
+	{name: "lifestyle_data", typeColumn: "data_type"},
+	{name: "wearable_data", typeColumn: "data_type"},
+	{name: "health_recommendations", typeColumn: "recommendation_type"},
+}
+
+// getSummary computes the aggregates of all health data created by the user within
+// the window, bucketing days in its location. Raw records are only loaded when
+// the window includes them.
+func (r *HealthMonitoringRepo) getSummary(ctx context.Context, userID string, window storage.SummaryWindow) (*health.SummaryResponse, error) {
+	// Build the conditions based on the request parameters
+	filter := func() *conditions {
+		c := (&conditions{}).notDeleted()
+		c.equal("user_id", userID)
+		c.add("created_at >= " + c.arg(window.From))
+		c.add("created_at < " + c.arg(window.To))
+		return c
+	}
+
+	summaryResponse := &health.SummaryResponse{}
+	if window.Records > 0 {
+		if err := r.loadSummaryRecords(ctx, filter, window, summaryResponse); err != nil {
+			return nil, err
+		}
+	}
+
+	// Pre-populate one bucket per calendar day so that days without data are reported too
+	buckets := make(map[string]*health.DailyBucket)
+	for _, day := range window.Days() {
+		bucket := &health.DailyBucket{Date: day}
+		buckets[bucket.Date] = bucket
+		summaryResponse.DailyBuckets = append(summaryResponse.DailyBuckets, bucket)
+	}
+
+	for _, t := range summaryTables {
+		typeCounts, err := r.aggregateTypeCounts(ctx, t.name, t.typeColumn, filter())
+		if err != nil {
+			return nil, err
+		}
+		summaryResponse.TypeCounts = append(summaryResponse.TypeCounts, typeCounts...)
+
+		dailyCounts, err := r.aggregateDailyCounts(ctx, t.name, filter(), window.Location.String())
+		if err != nil {
+			return nil, err
+		}
+		for day, count := range dailyCounts {
+			bucket, ok := buckets[day]
+			if !ok {
+				continue
+			}
+			switch t.name {
+			case "medical_records":
+				bucket.MedicalRecords = count
+			case "genetic_data":
+				bucket.GeneticData = count
+			case "lifestyle_data":
+				bucket.LifestyleData = count
+			case "wearable_data":
+				bucket.WearableData = count
+			case "health_recommendations":
+				bucket.HealthRecommendations = count
+			}
+		}
+	}
+
+	// Retrieve wearable metrics over the whole window
+	wearableMetrics, err := r.aggregateWearableMetrics(ctx, filter(), "")
+	if err != nil {
+		return nil, err
+	}
+	summaryResponse.WearableMetrics = wearableMetrics[""]
+
+	// Retrieve wearable metrics per day
+	dailyWearableMetrics, err := r.aggregateWearableMetrics(ctx, filter(), window.Location.String())
+	if err != nil {
+		return nil, err
+	}
+	for day, metrics := range dailyWearableMetrics {
+		if bucket, ok := buckets[day]; ok {
+			bucket.WearableMetrics = metrics
+		}
+	}
+
+	return summaryResponse, nil
+}
+
+// loadSummaryRecords fills the raw record lists of the summary response with the
+// oldest records of the window, up to its limit.
+func (r *HealthMonitoringRepo) loadSummaryRecords(ctx context.Context, filter func() *conditions, window storage.SummaryWindow, summaryResponse *health.SummaryResponse) error {
+	var err error
+	limit := window.Records + 1
+
+	// Retrieve medical records
+	summaryResponse.MedicalRecords, err = findAll(ctx, r.db, medicalRecords, filter(), limit, r.medicalRecordRepo.scan)
+	if err != nil {
+		return errs.Wrap(err, "failed to retrieve medical records")
+	}
+
+	// Retrieve genetic data
+	summaryResponse.GeneticData, err = findAll(ctx, r.db, geneticData, filter(), limit, r.geneticDataRepo.scan)
+	if err != nil {
+		return errs.Wrap(err, "failed to retrieve genetic data")
+	}
+
+	// Retrieve lifestyle data
+	summaryResponse.LifestyleData, err = findAll(ctx, r.db, lifestyleData, filter(), limit, r.lifestyleDataRepo.scan)
+	if err != nil {
+		return errs.Wrap(err, "failed to retrieve lifestyle data")
+	}
+
+	// Retrieve wearable data
+	summaryResponse.WearableData, err = findAll(ctx, r.db, wearableData, filter(), limit, r.wearableDataRepo.scan)
+	if err != nil {
+		return errs.Wrap(err, "failed to retrieve wearable data")
+	}
+
+	// Retrieve health recommendations
+	summaryResponse.HealthRecommendations, err = findAll(ctx, r.db, healthRecommendations, filter(), limit, r.healthRecommendationRepo.scan)
+	if err != nil {
+		return errs.Wrap(err, "failed to retrieve health recommendations")
+	}
+
+	// Keep the oldest records of each collection, loaded with one more to tell
+	// whether any were left out
+	truncated := &summaryResponse.RecordsTruncated
+	summaryResponse.MedicalRecords = storage.LimitSummaryRecords(summaryResponse.MedicalRecords, window, truncated)
+	summaryResponse.GeneticData = storage.LimitSummaryRecords(summaryResponse.GeneticData, window, truncated)
+	summaryResponse.LifestyleData = storage.LimitSummaryRecords(summaryResponse.LifestyleData, window, truncated)
+	summaryResponse.WearableData = storage.LimitSummaryRecords(summaryResponse.WearableData, window, truncated)
+	summaryResponse.HealthRecommendations = storage.LimitSummaryRecords(summaryResponse.HealthRecommendations, window, truncated)
+
+	return nil
+}
+
+// aggregateTypeCounts counts the matching rows of a table per value of typeColumn.
+func (r *HealthMonitoringRepo) aggregateTypeCounts(ctx context.Context, name, typeColumn string, c *conditions) ([]*health.TypeCount, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+typeColumn+", count(*) FROM "+name+c.where()+" GROUP BY "+typeColumn+" ORDER BY "+typeColumn, c.args...)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to aggregate %s type counts", name)
+	}
+	defer rows.Close()
+
+	var typeCounts []*health.TypeCount
+	for rows.Next() {
+		typeCount := &health.TypeCount{Collection: name}
+		if err := rows.Scan(&typeCount.Type, &typeCount.Count); err != nil {
+			return nil, errs.Wrap(err, "failed to decode %s type counts", name)
+		}
+		typeCounts = append(typeCounts, typeCount)
+	}
+	return typeCounts, rows.Err()
+}
+
+// aggregateDailyCounts counts the matching rows of a table per calendar day in the
+// time zone tz.
+func (r *HealthMonitoringRepo) aggregateDailyCounts(ctx context.Context, name string, c *conditions, tz string) (map[string]int64, error) {
+	day := summaryDayExpression(c, tz)
+	rows, err := r.db.QueryContext(ctx, "SELECT "+day+", count(*) FROM "+name+c.where()+" GROUP BY 1", c.args...)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to aggregate %s daily counts", name)
+	}
+	defer rows.Close()
+
+	dailyCounts := make(map[string]int64)
+	for rows.Next() {
+		var (
+			day   string
+			count int64
+		)
+		if err := rows.Scan(&day, &count); err != nil {
+			return nil, errs.Wrap(err, "failed to decode %s daily counts", name)
+		}
+		dailyCounts[day] = count
+	}
+	return dailyCounts, rows.Err()
+}
+
+// aggregateWearableMetrics computes statistics over the numeric wearable values per
+// data type. When tz is empty the whole window is aggregated under the "" key,
+// otherwise the metrics are grouped per calendar day in the time zone tz. The
+// percentiles are nearest-rank percentiles.
+func (r *HealthMonitoringRepo) aggregateWearableMetrics(ctx context.Context, c *conditions, tz string) (map[string][]*health.MetricStats, error) {
+	c.add("value IS NOT NULL")
+	day := "''"
+	if tz != "" {
+		day = summaryDayExpression(c, tz)
+	}
+	percentiles := c.arg(pq.Array(storage.SummaryPercentiles))
+
+	rows, err := r.db.QueryContext(ctx, "SELECT "+day+", data_type, count(*), min(value), max(value), avg(value),"+
+		" percentile_disc("+percentiles+"::float8[]) WITHIN GROUP (ORDER BY value)"+
+		" FROM wearable_data"+c.where()+" GROUP BY 1, 2 ORDER BY 1, 2", c.args...)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to aggregate wearable metrics")
+	}
+	defer rows.Close()
+
+	metrics := make(map[string][]*health.MetricStats)
+	for rows.Next() {
+		var (
+			day    string
+			stats  = &health.MetricStats{}
+			values []float64
+		)
+		if err := rows.Scan(&day, &stats.DataType, &stats.Count, &stats.Min, &stats.Max, &stats.Mean,
+			(*pq.Float64Array)(&values)); err != nil {
+			return nil, errs.Wrap(err, "failed to decode wearable metrics")
+		}
+		if len(values) == len(storage.SummaryPercentiles) {
+			stats.P50, stats.P90, stats.P99 = values[0], values[1], values[2]
+		}
+		metrics[day] = append(metrics[day], stats)
+	}
+	return metrics, rows.Err()
+}
+
+// summaryDayExpression renders created_at as a YYYY-MM-DD calendar day in the time
+// zone tz, passed as an argument of the conditions.
+func summaryDayExpression(c *conditions, tz string) string {
+	return "to_char(created_at AT TIME ZONE " + c.arg(tz) + ", 'YYYY-MM-DD')"
+}
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// pageRequest describes a single page of a keyset-paginated list query.
+type pageRequest struct {
+	size    int64
+	orderBy string // storage.OrderByCreatedAt or storage.OrderByID, both column names
+	desc    bool
+	after   *pageToken
+}
+
+// pageToken is the opaque cursor handed out as next_page_token. It holds the sort
+// keys of the last row of the previous page.
+type pageToken struct {
+	OrderBy   string `json:"o"`
+	CreatedAt int64  `json:"c,omitempty"` // Unix microseconds, matching timestamptz precision
+	ID        string `json:"i"`
+}
+
+// newPageRequest validates the pagination parameters of a list request.
+func newPageRequest(pageSize int32, token, orderBy string) (*pageRequest, error) {
+	size, err := storage.PageSize(pageSize)
+	if err != nil {
+		return nil, err
+	}
+	key, desc, err := storage.ParseOrderBy(orderBy)
+	if err != nil {
+		return nil, err
+	}
+	page := &pageRequest{size: size, orderBy: key, desc: desc}
+
+	if token != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			return nil, errs.InvalidArgument("page_token", "invalid page_token")
+		}
+		page.after = &pageToken{}
+		if err := json.Unmarshal(raw, page.after); err != nil {
+			return nil, errs.InvalidArgument("page_token", "invalid page_token")
+		}
+		if page.after.OrderBy != page.key() {
+			return nil, errs.InvalidArgument("page_token", "page_token does not match order_by")
+		}
+		if _, err := primitive.ObjectIDFromHex(page.after.ID); err != nil {
+			return nil, errs.InvalidArgument("page_token", "invalid page_token")
+		}
+	}
+
+	return page, nil
+}
+
+// key identifies the sort order so that tokens cannot be replayed against another order.
+func (p *pageRequest) key() string {
+	if p.desc {
+		return p.orderBy + " desc"
+	}
+	return p.orderBy + " asc"
+}
+
+// orderClause returns the ORDER BY clause of the page, always tie-broken by id.
+func (p *pageRequest) orderClause() string {
+	direction := "ASC"
+	if p.desc {
+		direction = "DESC"
+	}
+	if p.orderBy == storage.OrderByID {
+		return " ORDER BY id " + direction
+	}
+	return " ORDER BY " + p.orderBy + " " + direction + ", id " + direction
+}
+
+// addKeyset adds the condition selecting the rows after the page token.
+func (p *pageRequest) addKeyset(c *conditions) {
+	if p.after == nil {
+		return
+	}
+
+	op := " > "
+	if p.desc {
+		op = " < "
+	}
+	if p.orderBy == storage.OrderByID {
+		c.add("id" + op + c.arg(p.after.ID))
+		return
+	}
+	c.add("(" + p.orderBy + ", id)" + op + "(" + c.arg(time.UnixMicro(p.after.CreatedAt)) + ", " + c.arg(p.after.ID) + ")")
+}
+
+// nextToken builds the page token pointing after the given row.
+func (p *pageRequest) nextToken(id string, createdAt time.Time) (string, error) {
+	token := pageToken{OrderBy: p.key(), ID: id}
+	if p.orderBy == storage.OrderByCreatedAt {
+		token.CreatedAt = createdAt.UnixMicro()
+	}
+
+	raw, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// findPage runs a keyset-paginated query and returns the entities of the page, the
+// token of the next page (empty on the last page) and the total number of rows
+// matching the conditions.
+func findPage[T entity](ctx context.Context, db *sql.DB, t table, c *conditions, page *pageRequest, scan scanFunc[T]) ([]T, string, int64, error) {
+	var total int64
+	if err := db.QueryRowContext(ctx, "SELECT count(*) FROM "+t.name+c.where(), c.args...).Scan(&total); err != nil {
+		return nil, "", 0, errs.Wrap(err, "failed to count %s", t.name)
+	}
+
+	// Fetch one extra row to find out whether there is a next page
+	page.addKeyset(c)
+	query := "SELECT " + t.columns + ", created_at FROM " + t.name + c.where() + page.orderClause() +
+		fmt.Sprintf(" LIMIT %d", page.size+1)
+	rows, err := db.QueryContext(ctx, query, c.args...)
+	if err != nil {
+		return nil, "", 0, errs.Wrap(err, "failed to find %s", t.name)
+	}
+	defer rows.Close()
+
+	var (
+		entities  []T
+		createdAt time.Time
+		next      string
+	)
+	for rows.Next() {
+		if int64(len(entities)) == page.size {
+			next, err = page.nextToken(entities[len(entities)-1].GetId(), createdAt)
+			if err != nil {
+				return nil, "", 0, err
+			}
+			break
+		}
+		entity, err := scan(ctx, rows, &createdAt)
+		if err != nil {
+			return nil, "", 0, errs.Wrap(err, "failed to decode %s", t.resource)
+		}
+		entities = append(entities, entity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", 0, errs.Wrap(err, "failed to find %s", t.name)
+	}
+
+	return entities, next, total, nil
+}
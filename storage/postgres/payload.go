@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// dataValueJSON converts a data_value to its JSONB form, the protojson form of the
+// payload keyed by proto field names and tagged with its "@type". Enums are stored
+// as numbers and zero scalars are kept, so that payload filters compare them like
+// the other storages do. Empty values are stored as NULL.
+func dataValueJSON(dataValue *anypb.Any) (interface{}, error) {
+	if dataValue == nil {
+		return nil, nil
+	}
+	raw, err := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(dataValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data_value to JSON: %w", err)
+	}
+	return string(raw), nil
+}
+
+// parseDataValue converts a stored JSONB data_value back to an Any message.
+func parseDataValue(raw []byte) (*anypb.Any, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	dataValue := &anypb.Any{}
+	if err := protojson.Unmarshal(raw, dataValue); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal data_value from JSON: %w", err)
+	}
+	return dataValue, nil
+}
+
+// payloadOperators maps the operators of payload filters to SQL operators. NE also
+// matches missing fields and fields of another type, like in the other storages.
+var payloadOperators = map[health.PayloadFilter_Operator]string{
+	health.PayloadFilter_EQ:  "=",
+	health.PayloadFilter_NE:  "IS DISTINCT FROM",
+	health.PayloadFilter_GT:  ">",
+	health.PayloadFilter_GTE: ">=",
+	health.PayloadFilter_LT:  "<",
+	health.PayloadFilter_LTE: "<=",
+}
+
+// addPayloadFilters adds the data_value filters of a list request to the conditions.
+// A field is compared only when its JSON type matches the value; 64-bit integers,
+// which protojson renders as strings, compare as numbers. Numbers compare as float8,
+// the float64 of the other storages.
+func addPayloadFilters(c *conditions, filters []*health.PayloadFilter) error {
+	parsed, err := storage.ParsePayloadFilters(filters)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range parsed {
+		path := c.arg(pq.Array(f.Path)) + "::text[]"
+		field, text := "(data_value #> "+path+")", "(data_value #>> "+path+")"
+
+		var expr, value string
+		switch v := f.Value.(type) {
+		case float64:
+			expr = "CASE WHEN jsonb_typeof(" + field + ") = 'number'" +
+				" OR (jsonb_typeof(" + field + ") = 'string' AND " + text + " ~ '^-?[0-9]+$')" +
+				" THEN " + text + "::float8 END"
+			value = c.arg(v) + "::float8"
+		case string:
+			expr = "CASE WHEN jsonb_typeof(" + field + ") = 'string' THEN " + text + " END COLLATE \"C\""
+			value = c.arg(v) + "::text"
+		case bool:
+			expr = "CASE WHEN jsonb_typeof(" + field + ") = 'boolean' THEN " + text + "::boolean END"
+			value = c.arg(v) + "::boolean"
+		}
+		c.add(expr + " " + payloadOperators[f.Op] + " " + value)
+	}
+	return nil
+}
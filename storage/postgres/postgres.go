@@ -0,0 +1,121 @@
+// Package postgres implements the storage interfaces on PostgreSQL. The schema is
+// created by the SQL migrations of the migrations directory.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/health-analytics-service/health-analytics-service/config"
+	"github.com/health-analytics-service/health-analytics-service/encryption"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	_ "github.com/lib/pq" // Registers the "postgres" driver
+)
+
+// StorageP implements the storage.StorageI interface for PostgreSQL.
+type StorageP struct {
+	db                       *sql.DB
+	medicalRecordRepo        storage.MedicalRecordRepoI
+	geneticDataRepo          storage.GeneticDataRepoI
+	lifestyleDataRepo        storage.LifestyleDataRepoI
+	wearableDataRepo         storage.WearableDataRepoI
+	healthRecommendationRepo storage.HealthRecommendationRepoI
+	healthMonitoringRepo     storage.HealthMonitoringRepoI
+	auditLogRepo             storage.AuditLogRepoI
+	retentionRepo            storage.RetentionRepoI
+}
+
+// NewPostgresStorage creates a new PostgreSQL storage instance.
+func NewPostgresStorage(cfg config.Config) (storage.StorageI, error) {
+	db, err := Connect(cfg.PostgresHost, cfg.PostgresPort, cfg.PostgresUser, cfg.PostgresPassword, cfg.PostgresDB)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sensitive fields are encrypted with per-user data keys
+	cipher, err := encryption.NewCipherFromConfig(cfg, NewDataKeyStore(db))
+	if err != nil {
+		slog.Warn("Unable to configure field encryption:" + err.Error())
+		return nil, err
+	}
+
+	return NewStorage(db, cipher), nil
+}
+
+// NewStorage creates the PostgreSQL storage of a connected database whose sensitive
+// fields are encrypted with cipher.
+func NewStorage(db *sql.DB, cipher *encryption.Cipher) storage.StorageI {
+	return &StorageP{
+		db:                       db,
+		medicalRecordRepo:        NewMedicalRecordRepo(db, cipher),
+		geneticDataRepo:          NewGeneticDataRepo(db, cipher),
+		lifestyleDataRepo:        NewLifestyleDataRepo(db),
+		wearableDataRepo:         NewWearableDataRepo(db),
+		healthRecommendationRepo: NewHealthRecommendationRepo(db),
+		healthMonitoringRepo:     NewHealthMonitoringRepo(db, cipher),
+		auditLogRepo:             NewAuditLogRepo(db),
+		retentionRepo:            NewRetentionRepo(db),
+	}
+}
+
+// Connect connects to a PostgreSQL database.
+func Connect(host string, port int, user, password, dbName string) (*sql.DB, error) {
+	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
+		host, port, user, password, dbName)
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		slog.Warn("Unable to connect to PostgreSQL:" + err.Error())
+		return nil, err
+	}
+
+	// Ping the database to verify the connection
+	if err := db.PingContext(context.Background()); err != nil {
+		slog.Warn("Unable to ping PostgreSQL:" + err.Error())
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// MedicalRecord returns the MedicalRecordRepoI implementation for PostgreSQL.
+func (s *StorageP) MedicalRecord() storage.MedicalRecordRepoI {
+	return s.medicalRecordRepo
+}
+
+// GeneticData returns the GeneticDataRepoI implementation for PostgreSQL.
+func (s *StorageP) GeneticData() storage.GeneticDataRepoI {
+	return s.geneticDataRepo
+}
+
+// LifestyleData returns the LifestyleDataRepoI implementation for PostgreSQL.
+func (s *StorageP) LifestyleData() storage.LifestyleDataRepoI {
+	return s.lifestyleDataRepo
+}
+
+// WearableData returns the WearableDataRepoI implementation for PostgreSQL.
+func (s *StorageP) WearableData() storage.WearableDataRepoI {
+	return s.wearableDataRepo
+}
+
+// HealthRecommendation returns the HealthRecommendationRepoI implementation for PostgreSQL.
+func (s *StorageP) HealthRecommendation() storage.HealthRecommendationRepoI {
+	return s.healthRecommendationRepo
+}
+
+// HealthMonitoring returns the HealthMonitoringRepoI implementation for PostgreSQL.
+func (s *StorageP) HealthMonitoring() storage.HealthMonitoringRepoI {
+	return s.healthMonitoringRepo
+}
+
+// AuditLog returns the AuditLogRepoI implementation for PostgreSQL.
+func (s *StorageP) AuditLog() storage.AuditLogRepoI {
+	return s.auditLogRepo
+}
+
+// Retention returns the RetentionRepoI implementation for PostgreSQL.
+func (s *StorageP) Retention() storage.RetentionRepoI {
+	return s.retentionRepo
+}
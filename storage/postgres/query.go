@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"github.com/lib/pq"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// table describes the table of an entity.
+type table struct {
+	name     string // Table name
+	resource string // Name of the entity in errors
+	columns  string // Columns read by the scanner of the entity, in order
+}
+
+// entity is implemented by the proto messages stored in tables.
+type entity interface {
+	GetId() string
+}
+
+// scanner is implemented by *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanFunc reads an entity from the columns of its table, followed by the extra
+// destinations. Errors of the scanner are returned as they are.
+type scanFunc[T entity] func(ctx context.Context, s scanner, extra ...interface{}) (T, error)
+
+// conditions accumulates the conditions of a WHERE clause along with their
+// arguments, numbered $1, $2, ...
+type conditions struct {
+	clauses []string
+	args    []interface{}
+}
+
+// arg adds an argument and returns its placeholder.
+func (c *conditions) arg(value interface{}) string {
+	c.args = append(c.args, value)
+	return fmt.Sprintf("$%d", len(c.args))
+}
+
+// add adds a condition.
+func (c *conditions) add(clause string) {
+	c.clauses = append(c.clauses, clause)
+}
+
+// equal adds a condition matching column by exact value.
+func (c *conditions) equal(column string, value interface{}) {
+	c.add(column + " = " + c.arg(value))
+}
+
+// notDeleted restricts the conditions to rows that are not soft-deleted.
+func (c *conditions) notDeleted() *conditions {
+	c.add("deleted_at IS NULL")
+	return c
+}
+
+// where renders the WHERE clause, empty without conditions.
+func (c *conditions) where() string {
+	if len(c.clauses) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(c.clauses, " AND ")
+}
+
+// assignments accumulates the columns written by an insert or update along with
+// their values.
+type assignments struct {
+	columns []string
+	values  []interface{}
+}
+
+// set writes value to column.
+func (a *assignments) set(column string, value interface{}) {
+	a.columns = append(a.columns, column)
+	a.values = append(a.values, value)
+}
+
+// parseID validates the id of an entity, stored as a hexadecimal object id.
+func parseID(resource, id string) (string, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return "", errs.InvalidArgument("id", "invalid %s ID: %v", resource, err)
+	}
+	return objID.Hex(), nil
+}
+
+// newID returns the id of a new entity, generated unless given.
+func newID(resource, id string) (string, error) {
+	if id == "" {
+		return primitive.NewObjectID().Hex(), nil
+	}
+	return parseID(resource, id)
+}
+
+// isUniqueViolation reports whether err is caused by a duplicate key.
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+// textArray converts strings to a TEXT[] value, empty rather than NULL when nil.
+func textArray(values []string) interface{} {
+	return pq.StringArray(append([]string{}, values...))
+}
+
+// insert inserts the row of a new entity at version 1 and returns it.
+func insert[T entity](ctx context.Context, db *sql.DB, t table, id string, values *assignments, scan scanFunc[T]) (T, error) {
+	columns := append([]string{"id"}, values.columns...)
+	args := append([]interface{}{id}, values.values...)
+	placeholders := make([]string, len(args))
+	for i := range args {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+
+	query := "INSERT INTO " + t.name + " (" + strings.Join(columns, ", ") + ") VALUES (" + strings.Join(placeholders, ", ") + ")" +
+		" RETURNING " + t.columns
+	created, err := scan(ctx, db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		var zero T
+		if isUniqueViolation(err) {
+			return zero, errs.AlreadyExists(t.resource, id)
+		}
+		return zero, errs.Wrap(err, "failed to create %s", t.resource)
+	}
+	return created, nil
+}
+
+// getByID reads an entity by id, excluding soft-deleted rows unless ctx includes them.
+func getByID[T entity](ctx context.Context, db *sql.DB, t table, id string, scan scanFunc[T]) (T, error) {
+	c := &conditions{}
+	c.equal("id", id)
+	if !storage.IncludesDeleted(ctx) {
+		c.notDeleted()
+	}
+
+	found, err := scan(ctx, db.QueryRowContext(ctx, "SELECT "+t.columns+" FROM "+t.name+c.where(), c.args...))
+	if err != nil {
+		var zero T
+		if errors.Is(err, sql.ErrNoRows) {
+			return zero, errs.NotFound(t.resource, id)
+		}
+		return zero, errs.Wrap(err, "failed to get %s by ID", t.resource)
+	}
+	return found, nil
+}
+
+// findAll reads the first limit entities matching the conditions, oldest first.
+func findAll[T entity](ctx context.Context, db *sql.DB, t table, c *conditions, limit int64, scan scanFunc[T]) ([]T, error) {
+	query := "SELECT " + t.columns + " FROM " + t.name + c.where() + " ORDER BY created_at, id LIMIT " + c.arg(limit)
+	rows, err := db.QueryContext(ctx, query, c.args...)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to find %s", t.name)
+	}
+	defer rows.Close()
+
+	var found []T
+	for rows.Next() {
+		entity, err := scan(ctx, rows)
+		if err != nil {
+			return nil, errs.Wrap(err, "failed to decode %s", t.resource)
+		}
+		found = append(found, entity)
+	}
+	return found, rows.Err()
+}
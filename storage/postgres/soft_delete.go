@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+)
+
+// softDeleteTables lists the tables whose rows are soft-deleted. The prior revisions
+// of medical records are removed along with them by their foreign key.
+var softDeleteTables = []string{
+	"medical_records",
+	"genetic_data",
+	"lifestyle_data",
+	"wearable_data",
+	"health_recommendations",
+}
+
+// softDelete marks a row as deleted by the actor of ctx. Rows already deleted are
+// reported as not found.
+func softDelete(ctx context.Context, db *sql.DB, t table, id string) error {
+	result, err := db.ExecContext(ctx,
+		"UPDATE "+t.name+" SET deleted_at = now(), deleted_by = $2 WHERE id = $1 AND deleted_at IS NULL",
+		id, storage.ActorFromContext(ctx))
+	if err != nil {
+		return errs.Wrap(err, "failed to delete %s", t.resource)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return errs.Wrap(err, "failed to delete %s", t.resource)
+	}
+	if deleted == 0 {
+		return errs.NotFound(t.resource, id)
+	}
+	return nil
+}
+
+// restore clears the deletion markers of a soft-deleted row and returns its entity.
+func restore[T entity](ctx context.Context, db *sql.DB, t table, id string, scan scanFunc[T]) (T, error) {
+	restored, err := scan(ctx, db.QueryRowContext(ctx,
+		"UPDATE "+t.name+" SET deleted_at = NULL, deleted_by = NULL, updated_at = now() WHERE id = $1 AND deleted_at IS NOT NULL"+
+			" RETURNING "+t.columns,
+		id))
+	if err == nil {
+		return restored, nil
+	}
+	var zero T
+	if !errors.Is(err, sql.ErrNoRows) {
+		return zero, errs.Wrap(err, "failed to restore %s", t.resource)
+	}
+
+	// Tell rows that are not deleted apart from missing (or purged) ones
+	var exists bool
+	if err := db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM "+t.name+" WHERE id = $1)", id).Scan(&exists); err != nil {
+		return zero, errs.Wrap(err, "failed to restore %s", t.resource)
+	}
+	if exists {
+		return zero, errs.FailedPrecondition(t.resource+"/"+id, "%s %s is not deleted", t.resource, id)
+	}
+	return zero, errs.NotFound(t.resource, id)
+}
+
+// RetentionRepo implements the storage.RetentionRepoI interface for PostgreSQL.
+type RetentionRepo struct {
+	db *sql.DB
+}
+
+// NewRetentionRepo creates a new RetentionRepo instance.
+func NewRetentionRepo(db *sql.DB) *RetentionRepo {
+	return &RetentionRepo{
+		db: db,
+	}
+}
+
+// PurgeDeleted permanently removes the rows soft-deleted before deletedBefore from
+// all soft-deleted tables, along with their prior revisions.
+func (r *RetentionRepo) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var purged int64
+	for _, name := range softDeleteTables {
+		result, err := r.db.ExecContext(ctx, "DELETE FROM "+name+" WHERE deleted_at < $1", deletedBefore)
+		if err != nil {
+			return purged, errs.Wrap(err, "failed to purge deleted %s", name)
+		}
+		deleted, err := result.RowsAffected()
+		if err != nil {
+			return purged, errs.Wrap(err, "failed to purge deleted %s", name)
+		}
+		purged += deleted
+	}
+	return purged, nil
+}
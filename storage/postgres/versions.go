@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+)
+
+// staleVersion reports an update whose expected version is not the current one.
+func staleVersion(resource, id string, expected, current int64) error {
+	return errs.FailedPrecondition(resource+"/"+id, "%s %s is at version %d, not %d", resource, id, current, expected)
+}
+
+// updateVersioned updates an entity that is not soft-deleted with the assignments
+// built from its current owner and version, and increments its version. The row is
+// locked for the duration of the update, so that an expected version (if not 0) is
+// checked against the state the update applies to and fails with FailedPrecondition.
+// build may write to other tables within the same transaction. It returns the updated
+// entity.
+func updateVersioned[T entity](ctx context.Context, db *sql.DB, t table, id string, expected int64,
+	build func(tx *sql.Tx, owner string, version int64) (*assignments, error), scan scanFunc[T]) (T, error) {
+	var zero T
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return zero, errs.Wrap(err, "failed to update %s", t.resource)
+	}
+	defer tx.Rollback()
+
+	var (
+		owner   string
+		version int64
+	)
+	err = tx.QueryRowContext(ctx, "SELECT user_id, version FROM "+t.name+" WHERE id = $1 AND deleted_at IS NULL FOR UPDATE", id).
+		Scan(&owner, &version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return zero, errs.NotFound(t.resource, id)
+		}
+		return zero, errs.Wrap(err, "failed to get %s by ID", t.resource)
+	}
+	if expected != 0 && expected != version {
+		return zero, staleVersion(t.resource, id, expected, version)
+	}
+
+	values, err := build(tx, owner, version)
+	if err != nil {
+		return zero, err
+	}
+	set := make([]string, 0, len(values.columns)+2)
+	for i, column := range values.columns {
+		set = append(set, fmt.Sprintf("%s = $%d", column, i+1))
+	}
+	set = append(set, "updated_at = now()", "version = version + 1")
+	query := "UPDATE " + t.name + " SET " + strings.Join(set, ", ") + fmt.Sprintf(" WHERE id = $%d", len(values.values)+1) +
+		" RETURNING " + t.columns
+
+	updated, err := scan(ctx, tx.QueryRowContext(ctx, query, append(values.values, id)...))
+	if err != nil {
+		return zero, errs.Wrap(err, "failed to update %s", t.resource)
+	}
+	if err := tx.Commit(); err != nil {
+		return zero, errs.Wrap(err, "failed to update %s", t.resource)
+	}
+	return updated, nil
+}
@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+)
+
+// wearableData is the table of wearable data.
+var wearableData = table{
+	name:     "wearable_data",
+	resource: "wearable data",
+	columns:  "id, user_id, device_type, data_type, data_value, recorded_timestamp, created_at, updated_at, version",
+}
+
+// WearableDataRepo implements the storage.WearableDataRepoI interface for PostgreSQL.
+type WearableDataRepo struct {
+	db *sql.DB
+}
+
+// NewWearableDataRepo creates a new WearableDataRepo instance.
+func NewWearableDataRepo(db *sql.DB) *WearableDataRepo {
+	return &WearableDataRepo{
+		db: db,
+	}
+}
+
+// CreateWearableData creates a new wearable data record in the database.
+func (r *WearableDataRepo) CreateWearableData(ctx context.Context, data *health.WearableData) (*health.WearableData, error) {
+	id, err := newID(wearableData.resource, data.Id)
+	if err != nil {
+		return nil, err
+	}
+	recordedTimestamp, err := timestampValue("recorded_timestamp", data.RecordedTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	values := &assignments{}
+	values.set("user_id", data.UserId)
+	values.set("device_type", data.DeviceType)
+	if err := setWearableDataValue(data, values); err != nil {
+		return nil, err
+	}
+	values.set("recorded_timestamp", recordedTimestamp)
+
+	return insert(ctx, r.db, wearableData, id, values, r.scan)
+}
+
+// GetWearableData retrieves a wearable data record by its ID.
+func (r *WearableDataRepo) GetWearableData(ctx context.Context, id string) (*health.WearableData, error) {
+	id, err := parseID(wearableData.resource, id)
+	if err != nil {
+		return nil, err
+	}
+	return getByID(ctx, r.db, wearableData, id, r.scan)
+}
+
+// UpdateWearableData updates an existing wearable data record in the database. With an
+// update_mask, only the masked fields are written; data_type and data_value always go
+// together.
+func (r *WearableDataRepo) UpdateWearableData(ctx context.Context, data *health.WearableData) (*health.WearableData, error) {
+	id, err := parseID(wearableData.resource, data.Id)
+	if err != nil {
+		return nil, err
+	}
+	masked := func(field string) bool { return storage.MaskIncludes(data.UpdateMask, field) }
+
+	values := &assignments{}
+	if masked("user_id") {
+		values.set("user_id", data.UserId)
+	}
+	if masked("device_type") {
+		values.set("device_type", data.DeviceType)
+	}
+	if masked("data_type") || masked("data_value") {
+		if err := setWearableDataValue(data, values); err != nil {
+			return nil, err
+		}
+	}
+	if masked("recorded_timestamp") {
+		recordedTimestamp, err := timestampValue("recorded_timestamp", data.RecordedTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		values.set("recorded_timestamp", recordedTimestamp)
+	}
+
+	return updateVersioned(ctx, r.db, wearableData, id, data.ExpectedVersion,
+		func(*sql.Tx, string, int64) (*assignments, error) {
+			return values, nil
+		}, r.scan)
+}
+
+// DeleteWearableData soft-deletes a wearable data record. It is excluded from all reads and can be
+// restored until it is purged by the retention job.
+func (r *WearableDataRepo) DeleteWearableData(ctx context.Context, id string) error {
+	id, err := parseID(wearableData.resource, id)
+	if err != nil {
+		return err
+	}
+	return softDelete(ctx, r.db, wearableData, id)
+}
+
+// RestoreWearableData restores a wearable data record that was soft-deleted and not purged yet.
+func (r *WearableDataRepo) RestoreWearableData(ctx context.Context, id string) (*health.WearableData, error) {
+	id, err := parseID(wearableData.resource, id)
+	if err != nil {
+		return nil, err
+	}
+	return restore(ctx, r.db, wearableData, id, r.scan)
+}
+
+// ListWearableData retrieves all wearable data records for a given user ID, applying filters if provided.
+func (r *WearableDataRepo) ListWearableData(ctx context.Context, req *health.ListWearableDataRequest) (*health.ListWearableDataResponse, error) {
+	// Build the conditions based on the request parameters
+	c := (&conditions{}).notDeleted()
+	if req.UserId != "" {
+		c.equal("user_id", req.UserId)
+	}
+	if req.DeviceType != "" {
+		c.equal("device_type", req.DeviceType)
+	}
+	if req.DataType != "" {
+		c.equal("data_type", req.DataType)
+	}
+	if err := addTimestampRange(c, "recorded_timestamp", req.RecordedTimestamp, req.RecordedTimestampFrom, req.RecordedTimestampTo); err != nil {
+		return nil, err
+	}
+	if err := addPayloadFilters(c, req.DataValueFilters); err != nil {
+		return nil, err
+	}
+
+	page, err := newPageRequest(req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	data, nextPageToken, totalSize, err := findPage(ctx, r.db, wearableData, c, page, r.scan)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list wearable data")
+	}
+
+	return &health.ListWearableDataResponse{
+		WearableData:  data,
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}, nil
+}
+
+// setWearableDataValue validates data_value against the data type and sets the data
+// type, data value and the numeric reading used by summary aggregations, NULL when
+// the payload has none.
+func setWearableDataValue(data *health.WearableData, values *assignments) error {
+	msg, _, err := storage.UnpackWearablePayload(data.DataType, data.DataValue)
+	if err != nil {
+		return err
+	}
+	dataValue, err := dataValueJSON(data.DataValue)
+	if err != nil {
+		return err
+	}
+	if msg == nil && data.DataValue != nil {
+		msg, _ = data.DataValue.UnmarshalNew()
+	}
+
+	var value interface{}
+	if msg != nil {
+		if v, ok := storage.WearableValue(msg); ok {
+			value = v
+		}
+	}
+
+	values.set("data_type", data.DataType)
+	values.set("data_value", dataValue)
+	values.set("value", value)
+	return nil
+}
+
+// scan reads wearable data.
+func (r *WearableDataRepo) scan(_ context.Context, s scanner, extra ...interface{}) (*health.WearableData, error) {
+	var (
+		data                 = &health.WearableData{}
+		dataValue            []byte
+		recordedTimestamp    sql.NullTime
+		createdAt, updatedAt time.Time
+	)
+	dest := append([]interface{}{&data.Id, &data.UserId, &data.DeviceType, &data.DataType, &dataValue, &recordedTimestamp,
+		&createdAt, &updatedAt, &data.Version}, extra...)
+	if err := s.Scan(dest...); err != nil {
+		return nil, err
+	}
+	data.RecordedTimestamp = formatTimestamp(recordedTimestamp)
+	data.CreatedAt = formatTime(createdAt)
+	data.UpdatedAt = formatTime(updatedAt)
+
+	var err error
+	if data.DataValue, err = parseDataValue(dataValue); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
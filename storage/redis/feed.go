@@ -0,0 +1,129 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+)
+
+// replayBatchSize is the number of missed notifications loaded per round trip.
+const replayBatchSize = 100
+
+// StreamNotifications calls send for every notification of the user as it is created,
+// until ctx is cancelled or send fails. When lastSeenID is set, the notifications
+// created after it are replayed first, oldest first; if it no longer exists, all
+// retained notifications are replayed.
+func (c *Client) StreamNotifications(ctx context.Context, userID, lastSeenID string, send func(*health.Notification) error) error {
+	// Subscribe before replaying so that no notification falls in between
+	sub := c.Subscribe(ctx, notificationChannel(userID))
+	defer sub.Close()
+	if _, err := sub.Receive(ctx); err != nil {
+		return errs.Wrap(err, "failed to subscribe to notifications")
+	}
+	live := sub.Channel()
+
+	replayed := map[string]bool{}
+	if lastSeenID != "" {
+		if err := c.replayNotifications(ctx, userID, lastSeenID, func(n *health.Notification) error {
+			replayed[n.Id] = true
+			return send(n)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-live:
+			if !ok {
+				return fmt.Errorf("notification subscription closed")
+			}
+			n, err := decodeNotification([]byte(msg.Payload), false)
+			if err != nil {
+				log.Printf("skipping malformed notification: %v", err)
+				continue
+			}
+			if replayed[n.Id] {
+				// Already sent during the replay
+				delete(replayed, n.Id)
+				continue
+			}
+			if err := send(n); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// replayNotifications sends the notifications of the user created after lastSeenID,
+// oldest first.
+func (c *Client) replayNotifications(ctx context.Context, userID, lastSeenID string, send func(*health.Notification) error) error {
+	var cursor *notificationToken
+	score, err := c.ZScore(ctx, notificationsKey(userID), lastSeenID).Result()
+	switch {
+	case err == redis.Nil:
+		// The last seen notification expired or was deleted: replay everything
+	case err != nil:
+		return errs.Wrap(err, "failed to find last seen notification")
+	default:
+		cursor = &notificationToken{Score: score, ID: lastSeenID}
+	}
+
+	for {
+		batch, err := c.notificationsSince(ctx, userID, cursor)
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+		last := batch[len(batch)-1]
+		cursor = &notificationToken{Score: last.Score, ID: last.Member.(string)}
+
+		notifications, err := c.loadNotifications(ctx, userID, batch)
+		if err != nil {
+			return err
+		}
+		for _, n := range notifications {
+			if n == nil {
+				continue
+			}
+			if err := send(n); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// notificationsSince returns up to replayBatchSize notification ids (with scores) that
+// follow cursor in oldest-first order, the reverse of notificationsAfter.
+func (c *Client) notificationsSince(ctx context.Context, userID string, cursor *notificationToken) ([]redis.Z, error) {
+	by := &redis.ZRangeBy{Min: "-inf", Max: "+inf", Count: replayBatchSize}
+	if cursor != nil {
+		score := formatScore(cursor.Score)
+		by.Min = score
+
+		// Skip the notifications sharing the cursor's score that were already sent
+		ties, err := c.ZRangeByScore(ctx, notificationsKey(userID), &redis.ZRangeBy{Min: score, Max: score}).Result()
+		if err != nil {
+			return nil, errs.Wrap(err, "failed to replay notifications")
+		}
+		for _, id := range ties {
+			if id <= cursor.ID {
+				by.Offset++
+			}
+		}
+	}
+
+	batch, err := c.ZRangeByScoreWithScores(ctx, notificationsKey(userID), by).Result()
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to replay notifications")
+	}
+	return batch, nil
+}
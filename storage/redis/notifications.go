@@ -0,0 +1,308 @@
+package redis
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+)
+
+const (
+	// defaultPageSize is used when a list request does not specify a page size.
+	defaultPageSize = 50
+	// maxPageSize caps the page size of list requests.
+	maxPageSize = 1000
+)
+
+func unreadKey(userID string) string        { return fmt.Sprintf("unread:%s", userID) }
+func notificationsKey(userID string) string { return fmt.Sprintf("notifications:%s", userID) }
+func notificationKey(id string) string      { return fmt.Sprintf("notification:%s", id) }
+
+// notificationChannel is the pub/sub channel on which new notifications of a user are published.
+func notificationChannel(userID string) string { return fmt.Sprintf("notification-feed:%s", userID) }
+
+// notificationScore orders notifications in the per-user sorted set. Scores are Unix
+// seconds with millisecond precision, compatible with the whole seconds stored earlier.
+func notificationScore(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1000
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', -1, 64)
+}
+
+// notificationToken is the opaque cursor handed out as next_page_token. It holds the
+// score and id of the last notification of the previous page.
+type notificationToken struct {
+	Score float64 `json:"s"`
+	ID    string  `json:"i"`
+}
+
+func encodeNotificationToken(z redis.Z) string {
+	raw, _ := json.Marshal(notificationToken{Score: z.Score, ID: z.Member.(string)})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeNotificationToken(token string) (*notificationToken, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errs.InvalidArgument("page_token", "invalid page_token")
+	}
+	var t notificationToken
+	if err := json.Unmarshal(raw, &t); err != nil || t.ID == "" {
+		return nil, errs.InvalidArgument("page_token", "invalid page_token")
+	}
+	return &t, nil
+}
+
+// ListNotifications returns a page of the user's notifications, newest first.
+func (c *Client) ListNotifications(ctx context.Context, req *health.ListNotificationsRequest) (*health.ListNotificationsResponse, error) {
+	if req.PageSize < 0 {
+		return nil, errs.InvalidArgument("page_size", "page_size must not be negative")
+	}
+	size := defaultPageSize
+	if req.PageSize > 0 {
+		size = min(int(req.PageSize), maxPageSize)
+	}
+	var cursor *notificationToken
+	if req.PageToken != "" {
+		token, err := decodeNotificationToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		cursor = token
+	}
+
+	if err := c.pruneNotifications(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	// Fetch one notification more than requested to know whether there is a next page.
+	// Expired and (with unread_only) read notifications are skipped, so a page may take
+	// several round trips.
+	var (
+		page   []*health.Notification
+		scores []redis.Z
+	)
+	for len(page) <= size {
+		batch, err := c.notificationsAfter(ctx, req.UserId, cursor, int64(size+1-len(page)))
+		if err != nil {
+			return nil, err
+		}
+		if len(batch) == 0 {
+			break
+		}
+		last := batch[len(batch)-1]
+		cursor = &notificationToken{Score: last.Score, ID: last.Member.(string)}
+
+		notifications, err := c.loadNotifications(ctx, req.UserId, batch)
+		if err != nil {
+			return nil, err
+		}
+		for i, n := range notifications {
+			if n == nil || (req.UnreadOnly && n.Read) {
+				continue
+			}
+			page = append(page, n)
+			scores = append(scores, batch[i])
+		}
+	}
+
+	response := &health.ListNotificationsResponse{}
+	if len(page) > size {
+		page = page[:size]
+		response.NextPageToken = encodeNotificationToken(scores[size-1])
+	}
+	response.Notifications = page
+
+	total, err := c.ZCard(ctx, notificationsKey(req.UserId)).Result()
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to count notifications")
+	}
+	response.TotalSize = total
+
+	return response, nil
+}
+
+// notificationsAfter returns up to count notification ids (with scores) that follow
+// cursor in newest-first order. Notifications sharing a score are ordered by id,
+// descending, like ZREVRANGEBYSCORE does.
+func (c *Client) notificationsAfter(ctx context.Context, userID string, cursor *notificationToken, count int64) ([]redis.Z, error) {
+	by := &redis.ZRangeBy{Min: "-inf", Max: "+inf", Count: count}
+	if cursor != nil {
+		score := formatScore(cursor.Score)
+		by.Max = score
+
+		// Skip the notifications sharing the cursor's score that were already returned
+		ties, err := c.ZRangeByScore(ctx, notificationsKey(userID), &redis.ZRangeBy{Min: score, Max: score}).Result()
+		if err != nil {
+			return nil, errs.Wrap(err, "failed to list notifications")
+		}
+		for _, id := range ties {
+			if id >= cursor.ID {
+				by.Offset++
+			}
+		}
+	}
+
+	batch, err := c.ZRevRangeByScoreWithScores(ctx, notificationsKey(userID), by).Result()
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list notifications")
+	}
+	return batch, nil
+}
+
+// loadNotifications loads the notifications of the given ids along with their read
+// state. Notifications whose data has expired are removed and returned as nil.
+func (c *Client) loadNotifications(ctx context.Context, userID string, batch []redis.Z) ([]*health.Notification, error) {
+	keys := make([]string, len(batch))
+	for i, z := range batch {
+		keys[i] = notificationKey(z.Member.(string))
+	}
+
+	pipe := c.Pipeline()
+	data := pipe.MGet(ctx, keys...)
+	unread := make([]*redis.BoolCmd, len(batch))
+	for i, z := range batch {
+		unread[i] = pipe.SIsMember(ctx, unreadKey(userID), z.Member)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errs.Wrap(err, "failed to load notifications")
+	}
+
+	notifications := make([]*health.Notification, len(batch))
+	var expired []string
+	for i, raw := range data.Val() {
+		id := batch[i].Member.(string)
+		value, ok := raw.(string)
+		if !ok {
+			expired = append(expired, id)
+			continue
+		}
+
+		n, err := decodeNotification([]byte(value), !unread[i].Val())
+		if err != nil {
+			return nil, errs.Wrap(err, "failed to decode notification %s", id)
+		}
+		notifications[i] = n
+	}
+
+	if len(expired) > 0 {
+		if err := c.removeNotifications(ctx, userID, expired); err != nil {
+			return nil, err
+		}
+	}
+	return notifications, nil
+}
+
+// decodeNotification converts a stored notification to its API representation.
+func decodeNotification(value []byte, read bool) (*health.Notification, error) {
+	var n Notification
+	if err := json.Unmarshal(value, &n); err != nil {
+		return nil, err
+	}
+	return &health.Notification{
+		Id:        n.ID,
+		UserId:    n.UserID,
+		Message:   n.Message,
+		CreatedAt: n.Created.UTC().Format(time.RFC3339),
+		Read:      read,
+	}, nil
+}
+
+// GetUnreadCount returns the number of unread notifications of the user.
+func (c *Client) GetUnreadCount(ctx context.Context, userID string) (int64, error) {
+	if err := c.pruneNotifications(ctx, userID); err != nil {
+		return 0, err
+	}
+	count, err := c.SCard(ctx, unreadKey(userID)).Result()
+	if err != nil {
+		return 0, errs.Wrap(err, "failed to count unread notifications")
+	}
+	return count, nil
+}
+
+// MarkRead marks the given notifications of the user as read and returns how many
+// of them were unread.
+func (c *Client) MarkRead(ctx context.Context, userID string, ids []string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	members := make([]interface{}, len(ids))
+	for i, id := range ids {
+		members[i] = id
+	}
+	updated, err := c.SRem(ctx, unreadKey(userID), members...).Result()
+	if err != nil {
+		return 0, errs.Wrap(err, "failed to mark notifications as read")
+	}
+	return updated, nil
+}
+
+// MarkAllRead marks all notifications of the user as read and returns how many
+// were unread.
+func (c *Client) MarkAllRead(ctx context.Context, userID string) (int64, error) {
+	pipe := c.TxPipeline()
+	count := pipe.SCard(ctx, unreadKey(userID))
+	pipe.Del(ctx, unreadKey(userID))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, errs.Wrap(err, "failed to mark notifications as read")
+	}
+	return count.Val(), nil
+}
+
+// DeleteNotification deletes a notification of the user.
+func (c *Client) DeleteNotification(ctx context.Context, userID, id string) error {
+	removed, err := c.ZRem(ctx, notificationsKey(userID), id).Result()
+	if err != nil {
+		return errs.Wrap(err, "failed to delete notification")
+	}
+	if removed == 0 {
+		return errs.NotFound("notification", id)
+	}
+	return c.removeNotifications(ctx, userID, []string{id})
+}
+
+// pruneNotifications removes the notifications of the user that are older than the TTL.
+func (c *Client) pruneNotifications(ctx context.Context, userID string) error {
+	if c.notificationTTL <= 0 {
+		return nil
+	}
+	cutoff := notificationScore(time.Now().Add(-c.notificationTTL))
+	ids, err := c.ZRangeByScore(ctx, notificationsKey(userID), &redis.ZRangeBy{
+		Min: "-inf",
+		Max: "(" + formatScore(cutoff),
+	}).Result()
+	if err != nil {
+		return errs.Wrap(err, "failed to prune notifications")
+	}
+	return c.removeNotifications(ctx, userID, ids)
+}
+
+// removeNotifications removes the given notifications from all keys of the user.
+func (c *Client) removeNotifications(ctx context.Context, userID string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	members := make([]interface{}, len(ids))
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		members[i] = id
+		keys[i] = notificationKey(id)
+	}
+
+	pipe := c.TxPipeline()
+	pipe.ZRem(ctx, notificationsKey(userID), members...)
+	pipe.SRem(ctx, unreadKey(userID), members...)
+	pipe.Del(ctx, keys...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errs.Wrap(err, "failed to remove notifications")
+	}
+	return nil
+}
@@ -3,17 +3,18 @@ package redis
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
 	"github.com/health-analytics-service/health-analytics-service/config"
+	"github.com/health-analytics-service/health-analytics-service/errs"
 )
 
 // Client represents a Redis client.
 type Client struct {
 	*redis.Client
+	notificationTTL time.Duration // Zero keeps notifications forever
 }
 
 // Connect establishes a connection to the Redis server.
@@ -26,10 +27,10 @@ func Connect(cfg *config.Config) (*Client, error) {
 
 	// Test the connection
 	if _, err := client.Ping(context.Background()).Result(); err != nil {
-		return nil, fmt.Errorf("redis connection failed: %w", err)
+		return nil, errs.Wrap(err, "redis connection failed")
 	}
 
-	return &Client{client}, nil
+	return &Client{Client: client, notificationTTL: cfg.NotificationTTL}, nil
 }
 
 // Add these new methods for notification handling
@@ -40,6 +41,8 @@ type Notification struct {
 	Created time.Time `json:"created"`
 }
 
+// AddNotification stores a new unread notification for the user. Notifications expire
+// after the configured notification TTL.
 func (c *Client) AddNotification(ctx context.Context, user_id, message string) error {
 	notification := Notification{
 		ID:      uuid.NewString(),
@@ -55,17 +58,28 @@ func (c *Client) AddNotification(ctx context.Context, user_id, message string) e
 	pipe := c.Pipeline()
 
 	// Add to unread set
-	pipe.SAdd(ctx, fmt.Sprintf("unread:%s", notification.UserID), notification.ID)
+	pipe.SAdd(ctx, unreadKey(notification.UserID), notification.ID)
 
 	// Add to sorted set for ordering
-	pipe.ZAdd(ctx, fmt.Sprintf("notifications:%s", notification.UserID), &redis.Z{
-		Score:  float64(notification.Created.Unix()),
+	pipe.ZAdd(ctx, notificationsKey(notification.UserID), &redis.Z{
+		Score:  notificationScore(notification.Created),
 		Member: notification.ID,
 	})
 
 	// Store notification data
-	pipe.Set(ctx, fmt.Sprintf("notification:%s", notification.ID), json, 0)
+	pipe.Set(ctx, notificationKey(notification.ID), json, c.notificationTTL)
 
-	_, err = pipe.Exec(ctx)
-	return err
+	// The per-user sets outlive their newest notification by at most the TTL
+	if c.notificationTTL > 0 {
+		pipe.Expire(ctx, unreadKey(notification.UserID), c.notificationTTL)
+		pipe.Expire(ctx, notificationsKey(notification.UserID), c.notificationTTL)
+	}
+
+	// Push to live subscribers
+	pipe.Publish(ctx, notificationChannel(notification.UserID), json)
+
+	if _, err = pipe.Exec(ctx); err != nil {
+		return err
+	}
+	return c.pruneNotifications(ctx, notification.UserID)
 }
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
 )
@@ -14,54 +15,79 @@ type StorageI interface {
 	WearableData() WearableDataRepoI
 	HealthRecommendation() HealthRecommendationRepoI
 	HealthMonitoring() HealthMonitoringRepoI
+	AuditLog() AuditLogRepoI
+	Retention() RetentionRepoI
 }
 
 // MedicalRecordRepoI defines methods for interacting with medical records in MongoDB.
 type MedicalRecordRepoI interface {
-	CreateMedicalRecord(ctx context.Context, record *health.MedicalRecord) (string, error)
+	CreateMedicalRecord(ctx context.Context, record *health.MedicalRecord) (*health.MedicalRecord, error)
 	GetMedicalRecord(ctx context.Context, id string) (*health.MedicalRecord, error)
-	UpdateMedicalRecord(ctx context.Context, record *health.MedicalRecord) error
+	UpdateMedicalRecord(ctx context.Context, record *health.MedicalRecord) (*health.MedicalRecord, error)
 	DeleteMedicalRecord(ctx context.Context, id string) error
-	ListMedicalRecords(ctx context.Context, req *health.ListMedicalRecordsRequest) ([]*health.MedicalRecord, error)
+	RestoreMedicalRecord(ctx context.Context, id string) (*health.MedicalRecord, error)
+	ListMedicalRecords(ctx context.Context, req *health.ListMedicalRecordsRequest) (*health.ListMedicalRecordsResponse, error)
+	ListMedicalRecordVersions(ctx context.Context, req *health.ListMedicalRecordVersionsRequest) (*health.ListMedicalRecordVersionsResponse, error)
+	GetMedicalRecordVersion(ctx context.Context, id string, version int64) (*health.MedicalRecord, error)
 }
 
 // GeneticDataRepoI defines methods for interacting with genetic data in MongoDB.
 type GeneticDataRepoI interface {
-	CreateGeneticData(ctx context.Context, data *health.GeneticData) (string, error)
+	CreateGeneticData(ctx context.Context, data *health.GeneticData) (*health.GeneticData, error)
 	GetGeneticData(ctx context.Context, id string) (*health.GeneticData, error)
-	UpdateGeneticData(ctx context.Context, data *health.GeneticData) error
+	UpdateGeneticData(ctx context.Context, data *health.GeneticData) (*health.GeneticData, error)
 	DeleteGeneticData(ctx context.Context, id string) error
-	ListGeneticData(ctx context.Context, req *health.ListGeneticDataRequest) ([]*health.GeneticData, error)
+	RestoreGeneticData(ctx context.Context, id string) (*health.GeneticData, error)
+	ListGeneticData(ctx context.Context, req *health.ListGeneticDataRequest) (*health.ListGeneticDataResponse, error)
 }
 
 // LifestyleDataRepoI defines methods for interacting with lifestyle data in MongoDB.
 type LifestyleDataRepoI interface {
-	CreateLifestyleData(ctx context.Context, data *health.LifestyleData) (string, error)
+	CreateLifestyleData(ctx context.Context, data *health.LifestyleData) (*health.LifestyleData, error)
 	GetLifestyleData(ctx context.Context, id string) (*health.LifestyleData, error)
-	UpdateLifestyleData(ctx context.Context, data *health.LifestyleData) error
+	UpdateLifestyleData(ctx context.Context, data *health.LifestyleData) (*health.LifestyleData, error)
 	DeleteLifestyleData(ctx context.Context, id string) error
-	ListLifestyleData(ctx context.Context, req *health.ListLifestyleDataRequest) ([]*health.LifestyleData, error)
+	RestoreLifestyleData(ctx context.Context, id string) (*health.LifestyleData, error)
+	ListLifestyleData(ctx context.Context, req *health.ListLifestyleDataRequest) (*health.ListLifestyleDataResponse, error)
 }
 
 // WearableDataRepoI defines methods for interacting with wearable data in MongoDB.
 type WearableDataRepoI interface {
-	CreateWearableData(ctx context.Context, data *health.WearableData) (string, error)
+	CreateWearableData(ctx context.Context, data *health.WearableData) (*health.WearableData, error)
 	GetWearableData(ctx context.Context, id string) (*health.WearableData, error)
-	UpdateWearableData(ctx context.Context, data *health.WearableData) error
+	UpdateWearableData(ctx context.Context, data *health.WearableData) (*health.WearableData, error)
 	DeleteWearableData(ctx context.Context, id string) error
-	ListWearableData(ctx context.Context, req *health.ListWearableDataRequest) ([]*health.WearableData, error)
+	RestoreWearableData(ctx context.Context, id string) (*health.WearableData, error)
+	ListWearableData(ctx context.Context, req *health.ListWearableDataRequest) (*health.ListWearableDataResponse, error)
 }
 
 // HealthRecommendationRepoI defines methods for interacting with health recommendations in MongoDB.
 type HealthRecommendationRepoI interface {
-	CreateHealthRecommendation(ctx context.Context, recommendation *health.HealthRecommendation) (string, error)
+	CreateHealthRecommendation(ctx context.Context, recommendation *health.HealthRecommendation) (*health.HealthRecommendation, error)
 	GetHealthRecommendation(ctx context.Context, id string) (*health.HealthRecommendation, error)
-	UpdateHealthRecommendation(ctx context.Context, recommendation *health.HealthRecommendation) error
+	UpdateHealthRecommendation(ctx context.Context, recommendation *health.HealthRecommendation) (*health.HealthRecommendation, error)
 	DeleteHealthRecommendation(ctx context.Context, id string) error
-	ListHealthRecommendations(ctx context.Context, req *health.ListHealthRecommendationsRequest) ([]*health.HealthRecommendation, error)
+	RestoreHealthRecommendation(ctx context.Context, id string) (*health.HealthRecommendation, error)
+	ListHealthRecommendations(ctx context.Context, req *health.ListHealthRecommendationsRequest) (*health.ListHealthRecommendationsResponse, error)
 }
 
 type HealthMonitoringRepoI interface {
 	GetDailySummary(ctx context.Context, req *health.DailySummaryRequest) (*health.SummaryResponse, error)
 	GetWeeklySummary(ctx context.Context, req *health.WeeklySummaryRequest) (*health.SummaryResponse, error)
 }
+
+// AuditLogRepoI defines methods for the append-only audit log. Entries can only be
+// appended and queried, never updated or deleted.
+type AuditLogRepoI interface {
+	AppendAuditEntry(ctx context.Context, entry *health.AuditEntry) error
+	QueryAuditLog(ctx context.Context, req *health.QueryAuditLogRequest) (*health.QueryAuditLogResponse, error)
+}
+
+// RetentionRepoI defines methods for the permanent removal of soft-deleted data.
+// Delete methods only mark entities as deleted, so that they can be restored until
+// they are purged.
+type RetentionRepoI interface {
+	// PurgeDeleted permanently removes the entities deleted before deletedBefore and
+	// returns how many were removed.
+	PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int64, error)
+}
@@ -0,0 +1,152 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+)
+
+const (
+	// MaxSummaryDays is the maximum number of calendar days of a weekly summary,
+	// which reports one bucket per day.
+	MaxSummaryDays = 366
+	// MaxSummaryRecords caps the raw records of each collection in a summary.
+	MaxSummaryRecords = 1000
+)
+
+// SummaryWindow is the [From, To) time range covered by a summary, whose days are
+// calendar days in Location.
+type SummaryWindow struct {
+	From     time.Time
+	To       time.Time
+	Location *time.Location
+	// Records is the maximum number of raw records returned per collection, 0 when
+	// they are not included.
+	Records int64
+}
+
+// Days returns the calendar days of the window, formatted as YYYY-MM-DD.
+func (w SummaryWindow) Days() []string {
+	var days []string
+	for day := w.From; day.Before(w.To); day = day.AddDate(0, 0, 1) {
+		days = append(days, day.Format(DateLayout))
+	}
+	return days
+}
+
+// DailySummaryWindow returns the window of a daily summary. The day is interpreted as
+// a calendar day in the requested time zone (UTC by default) and defaults to the
+// current day when no date is given.
+func DailySummaryWindow(req *health.DailySummaryRequest) (SummaryWindow, error) {
+	loc, err := summaryLocation(req.TimeZone)
+	if err != nil {
+		return SummaryWindow{}, err
+	}
+
+	var day time.Time
+	if req.Date == "" {
+		now := time.Now().In(loc)
+		day = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	} else {
+		day, err = parseSummaryDate("date", req.Date, loc)
+		if err != nil {
+			return SummaryWindow{}, err
+		}
+	}
+	records, err := summaryRecords(req.IncludeRecords, req.MaxRecords)
+	if err != nil {
+		return SummaryWindow{}, err
+	}
+
+	return SummaryWindow{From: day, To: day.AddDate(0, 0, 1), Location: loc, Records: records}, nil
+}
+
+// WeeklySummaryWindow returns the window of a weekly summary. Both dates are
+// inclusive calendar days in the requested time zone (UTC by default), at most
+// MaxSummaryDays apart.
+func WeeklySummaryWindow(req *health.WeeklySummaryRequest) (SummaryWindow, error) {
+	loc, err := summaryLocation(req.TimeZone)
+	if err != nil {
+		return SummaryWindow{}, err
+	}
+
+	// Parse start and end dates
+	startDate, err := parseSummaryDate("start_date", req.StartDate, loc)
+	if err != nil {
+		return SummaryWindow{}, err
+	}
+	endDate, err := parseSummaryDate("end_date", req.EndDate, loc)
+	if err != nil {
+		return SummaryWindow{}, err
+	}
+	if endDate.Before(startDate) {
+		return SummaryWindow{}, errs.InvalidArgument("end_date", "end_date %s is before start_date %s", req.EndDate, req.StartDate)
+	}
+
+	// Add 1 day to include the end date
+	window := SummaryWindow{From: startDate, To: endDate.AddDate(0, 0, 1), Location: loc}
+	if window.From.AddDate(0, 0, MaxSummaryDays).Before(window.To) {
+		return SummaryWindow{}, errs.InvalidArgument("end_date", "the summary spans more than %d days", MaxSummaryDays)
+	}
+	if window.Records, err = summaryRecords(req.IncludeRecords, req.MaxRecords); err != nil {
+		return SummaryWindow{}, err
+	}
+	return window, nil
+}
+
+// LimitSummaryRecords trims records to the limit of the window, setting truncated
+// when any are left out. Storages load one record more than the limit to tell.
+func LimitSummaryRecords[T any](records []T, window SummaryWindow, truncated *bool) []T {
+	if int64(len(records)) > window.Records {
+		*truncated = true
+		return records[:window.Records]
+	}
+	return records
+}
+
+// SummaryPercentiles are the percentiles reported in MetricStats, in order p50, p90, p99.
+var SummaryPercentiles = []float64{0.5, 0.9, 0.99}
+
+// summaryLocation resolves an IANA time zone name, defaulting to UTC when empty.
+func summaryLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	// "Local" depends on the server's environment, not the user's, so it is rejected.
+	if name == "Local" {
+		return nil, errs.InvalidArgument("time_zone", "invalid time_zone %q: must be an IANA time zone name", name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errs.InvalidArgument("time_zone", "invalid time_zone %q: %v", name, err)
+	}
+	return loc, nil
+}
+
+// summaryRecords validates the max_records of a summary request and applies its
+// default and cap, returning 0 when records are not included.
+func summaryRecords(includeRecords bool, maxRecords int32) (int64, error) {
+	if maxRecords < 0 {
+		return 0, errs.InvalidArgument("max_records", "max_records must not be negative")
+	}
+	if !includeRecords {
+		return 0, nil
+	}
+	if maxRecords == 0 {
+		return MaxSummaryRecords, nil
+	}
+	return int64(min(maxRecords, MaxSummaryRecords)), nil
+}
+
+// parseSummaryDate parses a YYYY-MM-DD date as midnight in the given location.
+func parseSummaryDate(field, value string, loc *time.Location) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, errs.InvalidArgument(field, "%s is required", field)
+	}
+	date, err := time.ParseInLocation(DateLayout, value, loc)
+	if err != nil {
+		return time.Time{}, errs.InvalidArgument(field, "invalid %s %q: expected YYYY-MM-DD", field, value)
+	}
+	return date, nil
+}
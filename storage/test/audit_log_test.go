@@ -0,0 +1,75 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	mongodb "github.com/health-analytics-service/health-analytics-service/storage/mongo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLogRepo(t *testing.T) {
+	db := createMongoDBConnection(t)
+	auditLogRepo := mongodb.NewAuditLogRepo(db)
+
+	ctx := context.Background()
+	userID := uuid.NewString()
+	doctorID := uuid.NewString()
+	start := time.Now().UTC()
+	entries := []*health.AuditEntry{
+		{Actor: userID, ActorRoles: []string{"patient"}, Action: "create", ResourceType: "medical_record", ResourceId: "1", UserId: userID, Outcome: "OK", Source: "grpc"},
+		{Actor: doctorID, ActorRoles: []string{"doctor"}, Action: "read", ResourceType: "medical_record", ResourceId: "1", UserId: userID, Outcome: "OK", Source: "grpc"},
+		{Actor: doctorID, ActorRoles: []string{"doctor"}, Action: "read", ResourceType: "genetic_data", ResourceId: "2", UserId: userID, Outcome: "PermissionDenied", Source: "grpc"},
+	}
+	for _, entry := range entries {
+		assert.NoError(t, auditLogRepo.AppendAuditEntry(ctx, entry))
+		time.Sleep(5 * time.Millisecond) // Entries are ordered with millisecond precision
+	}
+
+	t.Run("QueryByUser", func(t *testing.T) {
+		page, err := auditLogRepo.QueryAuditLog(ctx, &health.QueryAuditLogRequest{UserId: userID, PageSize: 2})
+		assert.NoError(t, err)
+		assert.EqualValues(t, 3, page.TotalSize)
+		assert.Len(t, page.Entries, 2)
+		assert.Equal(t, "genetic_data", page.Entries[0].ResourceType)
+		assert.Equal(t, []string{"doctor"}, page.Entries[0].ActorRoles)
+		assert.Equal(t, "PermissionDenied", page.Entries[0].Outcome)
+		assert.NotEmpty(t, page.Entries[0].Timestamp)
+		assert.NotEmpty(t, page.NextPageToken)
+
+		next, err := auditLogRepo.QueryAuditLog(ctx, &health.QueryAuditLogRequest{UserId: userID, PageSize: 2, PageToken: page.NextPageToken})
+		assert.NoError(t, err)
+		assert.Len(t, next.Entries, 1)
+		assert.Equal(t, "create", next.Entries[0].Action)
+	})
+
+	t.Run("QueryByActorAndResource", func(t *testing.T) {
+		page, err := auditLogRepo.QueryAuditLog(ctx, &health.QueryAuditLogRequest{Actor: doctorID, ResourceType: "medical_record"})
+		assert.NoError(t, err)
+		assert.Len(t, page.Entries, 1)
+		assert.Equal(t, "1", page.Entries[0].ResourceId)
+	})
+
+	t.Run("QueryByTimeRange", func(t *testing.T) {
+		page, err := auditLogRepo.QueryAuditLog(ctx, &health.QueryAuditLogRequest{
+			UserId: userID,
+			From:   start.Add(-time.Minute).Format(time.RFC3339),
+			To:     start.Add(time.Minute).Format(time.RFC3339),
+		})
+		assert.NoError(t, err)
+		assert.Len(t, page.Entries, 3)
+
+		page, err = auditLogRepo.QueryAuditLog(ctx, &health.QueryAuditLogRequest{
+			UserId: userID,
+			To:     start.Add(-time.Minute).Format(time.RFC3339),
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, page.Entries)
+
+		_, err = auditLogRepo.QueryAuditLog(ctx, &health.QueryAuditLogRequest{From: "yesterday"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,551 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/health-analytics-service/health-analytics-service/config"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"github.com/health-analytics-service/health-analytics-service/storage/memory"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/typepb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// TestMemoryStorage runs the storage conformance suite against the in-memory storage.
+func TestMemoryStorage(t *testing.T) {
+	testStorageConformance(t, memory.NewStorage())
+}
+
+// TestMongoStorage runs the storage conformance suite against MongoDB.
+func TestMongoStorage(t *testing.T) {
+	s, err := NewMongoStorageTest(config.Load())
+	if err != nil {
+		t.Fatalf("failed to initialize storage: %v", err)
+	}
+	testStorageConformance(t, s)
+}
+
+// TestPostgresStorage runs the storage conformance suite against PostgreSQL.
+func TestPostgresStorage(t *testing.T) {
+	s, err := NewPostgresStorageTest(config.Load())
+	if err != nil {
+		t.Fatalf("failed to initialize storage: %v", err)
+	}
+	testStorageConformance(t, s)
+}
+
+// testStorageConformance checks the behavior every storage.StorageI implementation
+// must share. All data is created for fresh users, so the suite can run against a
+// database holding other data.
+func testStorageConformance(t *testing.T, s storage.StorageI) {
+	ctx := context.Background()
+	today := time.Now().UTC().Format(storage.DateLayout)
+
+	t.Run("MedicalRecords", func(t *testing.T) {
+		repo := s.MedicalRecord()
+		userID := uuid.NewString()
+
+		// 1. Create three records on different dates
+		var created []*health.MedicalRecord
+		for _, date := range []string{"2024-01-10", "2024-02-10", "2024-03-10T09:30:00Z"} {
+			record, err := repo.CreateMedicalRecord(ctx, &health.MedicalRecord{
+				UserId:      userID,
+				RecordType:  "diagnosis",
+				RecordDate:  date,
+				Description: "Checkup of " + date,
+				DoctorId:    "doctor-1",
+				Attachments: []string{"scan.pdf"},
+			})
+			assert.NoError(t, err)
+			created = append(created, record)
+		}
+		assert.Equal(t, int64(1), created[0].Version, "New records should be at version 1")
+		assert.Equal(t, "2024-03-10", created[2].RecordDate, "Timestamps should be stored as dates")
+
+		retrieved, err := repo.GetMedicalRecord(ctx, created[0].Id)
+		assert.NoError(t, err)
+		assert.Equal(t, created[0].Description, retrieved.Description)
+		assert.Equal(t, created[0].Attachments, retrieved.Attachments)
+
+		// 2. Ids are validated and unique
+		_, err = repo.GetMedicalRecord(ctx, "not-an-id")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = repo.GetMedicalRecord(ctx, primitive.NewObjectID().Hex())
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = repo.CreateMedicalRecord(ctx, &health.MedicalRecord{Id: created[0].Id, UserId: userID})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		// 3. Filters and pagination
+		list, err := repo.ListMedicalRecords(ctx, &health.ListMedicalRecordsRequest{
+			UserId: userID, RecordDateFrom: "2024-02-01", RecordDateTo: "2024-03-10",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), list.TotalSize, "The date range should include its end day")
+
+		list, err = repo.ListMedicalRecords(ctx, &health.ListMedicalRecordsRequest{UserId: userID, Description: "Checkup of 2024-01-10"})
+		assert.NoError(t, err)
+		if assert.Len(t, list.MedicalRecords, 1) {
+			assert.Equal(t, created[0].Id, list.MedicalRecords[0].Id)
+		}
+
+		var ids []string
+		req := &health.ListMedicalRecordsRequest{UserId: userID, PageSize: 2, OrderBy: "id"}
+		for {
+			page, err := repo.ListMedicalRecords(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(3), page.TotalSize)
+			for _, record := range page.MedicalRecords {
+				ids = append(ids, record.Id)
+			}
+			if page.NextPageToken == "" {
+				break
+			}
+			req.PageToken = page.NextPageToken
+		}
+		assert.Equal(t, []string{created[0].Id, created[1].Id, created[2].Id}, ids, "Pages should list all records in order")
+
+		for orderBy, first := range map[string]string{"": created[2].Id, "created_at": created[0].Id, "created_at desc": created[2].Id} {
+			list, err = repo.ListMedicalRecords(ctx, &health.ListMedicalRecordsRequest{UserId: userID, OrderBy: orderBy})
+			assert.NoError(t, err)
+			if assert.Len(t, list.MedicalRecords, 3) {
+				assert.Equal(t, first, list.MedicalRecords[0].Id, "order_by %q", orderBy)
+			}
+		}
+
+		_, err = repo.ListMedicalRecords(ctx, &health.ListMedicalRecordsRequest{UserId: userID, PageToken: req.PageToken})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "Tokens should not be replayed against another order")
+		_, err = repo.ListMedicalRecords(ctx, &health.ListMedicalRecordsRequest{OrderBy: "priority"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// 4. Updates only write the fields that are set, and keep prior revisions
+		updated, err := repo.UpdateMedicalRecord(ctx, &health.MedicalRecord{Id: created[0].Id, Description: "Follow-up", ExpectedVersion: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), updated.Version)
+		assert.Equal(t, "Follow-up", updated.Description)
+		assert.Equal(t, created[0].RecordType, updated.RecordType)
+
+		_, err = repo.UpdateMedicalRecord(ctx, &health.MedicalRecord{Id: created[0].Id, Description: "Stale", ExpectedVersion: 1})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Stale updates should be rejected")
+
+		updated, err = repo.UpdateMedicalRecord(ctx, &health.MedicalRecord{
+			Id:         created[0].Id,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"attachments"}},
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, updated.Attachments, "Masked empty fields should be cleared")
+
+		versions, err := repo.ListMedicalRecordVersions(ctx, &health.ListMedicalRecordVersionsRequest{Id: created[0].Id, PageSize: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), versions.TotalSize)
+		if assert.Len(t, versions.Versions, 1) {
+			assert.Equal(t, int64(2), versions.Versions[0].Version, "Versions should be listed newest first")
+		}
+		versions, err = repo.ListMedicalRecordVersions(ctx, &health.ListMedicalRecordVersionsRequest{Id: created[0].Id, PageToken: versions.NextPageToken})
+		assert.NoError(t, err)
+		if assert.Len(t, versions.Versions, 1) {
+			assert.Equal(t, int64(1), versions.Versions[0].Version)
+		}
+
+		first, err := repo.GetMedicalRecordVersion(ctx, created[0].Id, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, created[0].Description, first.Description)
+		_, err = repo.GetMedicalRecordVersion(ctx, created[0].Id, 9)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		// 5. Deleted records are hidden until restored
+		assert.NoError(t, repo.DeleteMedicalRecord(ctx, created[1].Id))
+		assert.Equal(t, codes.NotFound, status.Code(repo.DeleteMedicalRecord(ctx, created[1].Id)))
+		_, err = repo.GetMedicalRecord(ctx, created[1].Id)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = repo.UpdateMedicalRecord(ctx, &health.MedicalRecord{Id: created[1].Id, Description: "Deleted"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = repo.GetMedicalRecord(storage.WithDeleted(ctx), created[1].Id)
+		assert.NoError(t, err, "Deleted records should be found when included")
+
+		restored, err := repo.RestoreMedicalRecord(ctx, created[1].Id)
+		assert.NoError(t, err)
+		assert.Equal(t, created[1].Description, restored.Description)
+		_, err = repo.RestoreMedicalRecord(ctx, created[1].Id)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Only deleted records can be restored")
+
+		for _, record := range created {
+			repo.DeleteMedicalRecord(ctx, record.Id)
+		}
+	})
+
+	t.Run("GeneticData", func(t *testing.T) {
+		repo := s.GeneticData()
+		dataValue, err := anypb.New(&health.MedicalRecord{RecordType: "genetic_test", Description: "BRCA1 negative"})
+		assert.NoError(t, err)
+
+		created, err := repo.CreateGeneticData(ctx, &health.GeneticData{
+			UserId:       uuid.NewString(),
+			DataType:     "whole_genome",
+			DataValue:    dataValue,
+			AnalysisDate: "2024-05-01",
+		})
+		assert.NoError(t, err)
+		defer repo.DeleteGeneticData(ctx, created.Id)
+
+		retrieved, err := repo.GetGeneticData(ctx, created.Id)
+		assert.NoError(t, err)
+		payload := &health.MedicalRecord{}
+		assert.NoError(t, retrieved.DataValue.UnmarshalTo(payload))
+		assert.Equal(t, "BRCA1 negative", payload.Description)
+
+		updated, err := repo.UpdateGeneticData(ctx, &health.GeneticData{
+			Id:           created.Id,
+			AnalysisDate: "2024-06-01",
+			UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"analysis_date"}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "2024-06-01", updated.AnalysisDate)
+		assert.Equal(t, created.DataType, updated.DataType, "Fields outside of the mask should be kept")
+		assert.NotNil(t, updated.DataValue)
+
+		list, err := repo.ListGeneticData(ctx, &health.ListGeneticDataRequest{UserId: created.UserId, AnalysisDate: "2024-06-01"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), list.TotalSize)
+
+		_, err = repo.ListGeneticData(ctx, &health.ListGeneticDataRequest{UserId: created.UserId, DataValueFilters: []*health.PayloadFilter{
+			{Field: "variant", Op: health.PayloadFilter_EQ, Value: structpb.NewStringValue("BRCA1")},
+		}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "Encrypted payloads cannot be filtered on")
+	})
+
+	t.Run("LifestyleData", func(t *testing.T) {
+		repo := s.LifestyleData()
+		dataValue, err := anypb.New(&health.SleepData{SleepDuration: int64(7 * time.Hour), SleepQuality: "Good"})
+		assert.NoError(t, err)
+
+		created, err := repo.CreateLifestyleData(ctx, &health.LifestyleData{
+			UserId:       uuid.NewString(),
+			DataType:     "sleep",
+			DataValue:    dataValue,
+			RecordedDate: "2024-05-01",
+		})
+		assert.NoError(t, err)
+		defer repo.DeleteLifestyleData(ctx, created.Id)
+
+		// Updates without a mask replace all fields
+		updated, err := repo.UpdateLifestyleData(ctx, &health.LifestyleData{
+			Id:           created.Id,
+			UserId:       created.UserId,
+			DataType:     "diet",
+			DataValue:    dataValue,
+			RecordedDate: "2024-05-02",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "diet", updated.DataType)
+		assert.Equal(t, int64(2), updated.Version)
+
+		list, err := repo.ListLifestyleData(ctx, &health.ListLifestyleDataRequest{UserId: created.UserId, DataType: "sleep"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), list.TotalSize)
+		_, err = repo.ListLifestyleData(ctx, &health.ListLifestyleDataRequest{RecordedDateFrom: "May 1st"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("WearableData", func(t *testing.T) {
+		repo := s.WearableData()
+		userID := uuid.NewString()
+		steps, err := anypb.New(&health.StepsData{Steps: 1200})
+		assert.NoError(t, err)
+		heartRate, err := anypb.New(&health.HeartRateData{HeartRate: 72})
+		assert.NoError(t, err)
+
+		_, err = repo.CreateWearableData(ctx, &health.WearableData{UserId: userID, DataType: "steps", DataValue: heartRate})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "Payloads should match their data type")
+
+		for _, ts := range []string{"2024-05-01T08:00:00Z", "2024-05-01T12:00:00Z", "2024-05-02T08:00:00+02:00"} {
+			created, err := repo.CreateWearableData(ctx, &health.WearableData{
+				UserId:            userID,
+				DeviceType:        "watch",
+				DataType:          "steps",
+				DataValue:         steps,
+				RecordedTimestamp: ts,
+			})
+			assert.NoError(t, err)
+			defer repo.DeleteWearableData(ctx, created.Id)
+		}
+
+		list, err := repo.ListWearableData(ctx, &health.ListWearableDataRequest{
+			UserId: userID, RecordedTimestampFrom: "2024-05-01T12:00:00Z", RecordedTimestampTo: "2024-05-02T08:00:00+01:00",
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, list.WearableData, 2, "The timestamp range should be half-open") {
+			assert.Equal(t, "2024-05-02T06:00:00Z", list.WearableData[0].RecordedTimestamp, "Timestamps should be stored in UTC")
+		}
+
+		list, err = repo.ListWearableData(ctx, &health.ListWearableDataRequest{
+			UserId: userID, RecordedTimestampFrom: "2024-05-01T12:00:00Z", RecordedTimestampTo: "2024-05-02T06:00:00Z",
+		})
+		assert.NoError(t, err)
+		assert.Len(t, list.WearableData, 1, "The end of the timestamp range should be excluded")
+
+		// Timestamps round-trip with their milliseconds
+		precise, err := repo.CreateWearableData(ctx, &health.WearableData{
+			UserId: userID, DataType: "steps", DataValue: steps, RecordedTimestamp: "2024-05-03T08:00:00.123456+02:00",
+		})
+		assert.NoError(t, err)
+		defer repo.DeleteWearableData(ctx, precise.Id)
+		retrieved, err := repo.GetWearableData(ctx, precise.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, "2024-05-03T06:00:00.123Z", retrieved.RecordedTimestamp)
+		list, err = repo.ListWearableData(ctx, &health.ListWearableDataRequest{UserId: userID, RecordedTimestamp: retrieved.RecordedTimestamp})
+		assert.NoError(t, err)
+		assert.Len(t, list.WearableData, 1, "Returned timestamps should match their stored value")
+	})
+
+	t.Run("PayloadFilters", func(t *testing.T) {
+		repo := s.WearableData()
+		userID := uuid.NewString()
+		for _, payload := range []proto.Message{
+			&health.HeartRateData{HeartRate: 58},
+			&health.HeartRateData{HeartRate: 72},
+			&health.HeartRateData{HeartRate: 130},
+			&health.StepsData{Steps: 4000},
+			&health.StepsData{Steps: 0},
+		} {
+			dataValue, err := anypb.New(payload)
+			assert.NoError(t, err)
+			dataType := "heart_rate"
+			if _, ok := payload.(*health.StepsData); ok {
+				dataType = "steps"
+			}
+			created, err := repo.CreateWearableData(ctx, &health.WearableData{UserId: userID, DataType: dataType, DataValue: dataValue})
+			assert.NoError(t, err)
+			defer repo.DeleteWearableData(ctx, created.Id)
+		}
+
+		count := func(filters ...*health.PayloadFilter) int64 {
+			list, err := repo.ListWearableData(ctx, &health.ListWearableDataRequest{UserId: userID, DataValueFilters: filters})
+			assert.NoError(t, err)
+			return list.GetTotalSize()
+		}
+		filter := func(field string, op health.PayloadFilter_Operator, value *structpb.Value) *health.PayloadFilter {
+			return &health.PayloadFilter{Field: field, Op: op, Value: value}
+		}
+
+		assert.Equal(t, int64(1), count(filter("heart_rate", health.PayloadFilter_EQ, structpb.NewNumberValue(72))))
+		assert.Equal(t, int64(2), count(filter("heart_rate", health.PayloadFilter_GTE, structpb.NewNumberValue(72))))
+		assert.Equal(t, int64(1), count(
+			filter("heart_rate", health.PayloadFilter_GT, structpb.NewNumberValue(60)),
+			filter("heart_rate", health.PayloadFilter_LT, structpb.NewNumberValue(100)),
+		), "All filters should match")
+		assert.Equal(t, int64(4), count(filter("heart_rate", health.PayloadFilter_NE, structpb.NewNumberValue(72))),
+			"NE should match payloads without the field")
+		assert.Equal(t, int64(1), count(filter("steps", health.PayloadFilter_GT, structpb.NewNumberValue(3999))),
+			"64-bit integers should compare as numbers")
+		assert.Equal(t, int64(1), count(filter("steps", health.PayloadFilter_EQ, structpb.NewNumberValue(0))),
+			"Zero readings should match")
+		assert.Equal(t, int64(1), count(filter("steps", health.PayloadFilter_LTE, structpb.NewNumberValue(0))))
+		assert.Equal(t, int64(1), count(filter("steps", health.PayloadFilter_LT, structpb.NewNumberValue(1))))
+		assert.Equal(t, int64(0), count(filter("heart_rate", health.PayloadFilter_EQ, structpb.NewStringValue("72"))),
+			"Values of another type should not match")
+
+		_, err := repo.ListWearableData(ctx, &health.ListWearableDataRequest{DataValueFilters: []*health.PayloadFilter{
+			filter("heart_rate; DROP", health.PayloadFilter_EQ, structpb.NewNumberValue(1)),
+		}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = repo.ListWearableData(ctx, &health.ListWearableDataRequest{DataValueFilters: []*health.PayloadFilter{
+			filter("heart_rate", health.PayloadFilter_GT, structpb.NewBoolValue(true)),
+		}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Lifestyle data supports the same filters
+		sleep, err := anypb.New(&health.SleepData{SleepDuration: int64(7 * time.Hour), SleepQuality: "Good"})
+		assert.NoError(t, err)
+		lifestyle, err := s.LifestyleData().CreateLifestyleData(ctx, &health.LifestyleData{UserId: userID, DataType: "sleep", DataValue: sleep})
+		assert.NoError(t, err)
+		defer s.LifestyleData().DeleteLifestyleData(ctx, lifestyle.Id)
+
+		list, err := s.LifestyleData().ListLifestyleData(ctx, &health.ListLifestyleDataRequest{UserId: userID, DataValueFilters: []*health.PayloadFilter{
+			filter("sleep_quality", health.PayloadFilter_EQ, structpb.NewStringValue("Good")),
+		}})
+		assert.NoError(t, err)
+		if assert.Len(t, list.LifestyleData, 1) {
+			assert.True(t, proto.Equal(sleep, list.LifestyleData[0].DataValue), "Payloads should round-trip")
+		}
+
+		// Enums compare as their numbers, and unsigned integers by value beyond MaxInt64
+		field, err := anypb.New(&typepb.Field{Name: "note", Kind: typepb.Field_TYPE_STRING})
+		assert.NoError(t, err)
+		large, err := anypb.New(wrapperspb.UInt64(1<<63 + 4096))
+		assert.NoError(t, err)
+		for _, dataValue := range []*anypb.Any{field, large} {
+			created, err := s.LifestyleData().CreateLifestyleData(ctx, &health.LifestyleData{UserId: userID, DataType: "other", DataValue: dataValue})
+			assert.NoError(t, err)
+			defer s.LifestyleData().DeleteLifestyleData(ctx, created.Id)
+			retrieved, err := s.LifestyleData().GetLifestyleData(ctx, created.Id)
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(dataValue, retrieved.DataValue), "Payloads should round-trip")
+		}
+		countLifestyle := func(filters ...*health.PayloadFilter) int64 {
+			list, err := s.LifestyleData().ListLifestyleData(ctx, &health.ListLifestyleDataRequest{UserId: userID, DataValueFilters: filters})
+			assert.NoError(t, err)
+			return list.GetTotalSize()
+		}
+		assert.Equal(t, int64(1), countLifestyle(filter("kind", health.PayloadFilter_EQ, structpb.NewNumberValue(float64(typepb.Field_TYPE_STRING)))))
+		assert.Equal(t, int64(0), countLifestyle(filter("kind", health.PayloadFilter_EQ, structpb.NewStringValue("TYPE_STRING"))),
+			"Enums should not compare as their names")
+		assert.Equal(t, int64(1), countLifestyle(filter("value", health.PayloadFilter_GT, structpb.NewNumberValue(1<<63))))
+		assert.Equal(t, int64(0), countLifestyle(filter("value", health.PayloadFilter_LT, structpb.NewNumberValue(0))))
+	})
+
+	t.Run("HealthRecommendations", func(t *testing.T) {
+		repo := s.HealthRecommendation()
+		userID := uuid.NewString()
+		var created []*health.HealthRecommendation
+		for priority := int32(1); priority <= 3; priority++ {
+			recommendation, err := repo.CreateHealthRecommendation(ctx, &health.HealthRecommendation{
+				UserId:             userID,
+				RecommendationType: "exercise",
+				Description:        "Walk",
+				Priority:           priority,
+			})
+			assert.NoError(t, err)
+			defer repo.DeleteHealthRecommendation(ctx, recommendation.Id)
+			created = append(created, recommendation)
+		}
+
+		list, err := repo.ListHealthRecommendations(ctx, &health.ListHealthRecommendationsRequest{UserId: userID, Priority: 2})
+		assert.NoError(t, err)
+		if assert.Len(t, list.HealthRecommendations, 1) {
+			assert.Equal(t, created[1].Id, list.HealthRecommendations[0].Id)
+		}
+
+		list, err = repo.ListHealthRecommendations(ctx, &health.ListHealthRecommendationsRequest{UserId: userID})
+		assert.NoError(t, err)
+		if assert.Len(t, list.HealthRecommendations, 3) {
+			assert.Equal(t, created[2].Id, list.HealthRecommendations[0].Id, "Lists should be newest first by default")
+		}
+		_, err = repo.ListHealthRecommendations(ctx, &health.ListHealthRecommendationsRequest{PageSize: -1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Summaries", func(t *testing.T) {
+		userID := uuid.NewString()
+		record, err := s.MedicalRecord().CreateMedicalRecord(ctx, &health.MedicalRecord{UserId: userID, RecordType: "diagnosis", RecordDate: today})
+		assert.NoError(t, err)
+		defer s.MedicalRecord().DeleteMedicalRecord(ctx, record.Id)
+		for _, count := range []int64{1000, 3000} {
+			steps, err := anypb.New(&health.StepsData{Steps: count})
+			assert.NoError(t, err)
+			data, err := s.WearableData().CreateWearableData(ctx, &health.WearableData{
+				UserId: userID, DeviceType: "watch", DataType: "steps", DataValue: steps, RecordedTimestamp: time.Now().Format(time.RFC3339),
+			})
+			assert.NoError(t, err)
+			defer s.WearableData().DeleteWearableData(ctx, data.Id)
+		}
+		deleted, err := s.MedicalRecord().CreateMedicalRecord(ctx, &health.MedicalRecord{UserId: userID, RecordType: "imaging", RecordDate: today})
+		assert.NoError(t, err)
+		assert.NoError(t, s.MedicalRecord().DeleteMedicalRecord(ctx, deleted.Id))
+
+		summary, err := s.HealthMonitoring().GetDailySummary(ctx, &health.DailySummaryRequest{UserId: userID, IncludeRecords: true})
+		assert.NoError(t, err)
+		assert.Len(t, summary.MedicalRecords, 1, "Deleted records should not be summarized")
+		assert.Len(t, summary.WearableData, 2)
+		assert.False(t, summary.RecordsTruncated)
+		assert.Equal(t, []*health.TypeCount{
+			{Collection: "medical_records", Type: "diagnosis", Count: 1},
+			{Collection: "wearable_data", Type: "steps", Count: 2},
+		}, summary.TypeCounts)
+		if assert.Len(t, summary.DailyBuckets, 1) {
+			assert.Equal(t, today, summary.DailyBuckets[0].Date)
+			assert.Equal(t, int64(2), summary.DailyBuckets[0].WearableData)
+		}
+		if assert.Len(t, summary.WearableMetrics, 1) {
+			metrics := summary.WearableMetrics[0]
+			assert.Equal(t, int64(2), metrics.Count)
+			assert.Equal(t, 1000.0, metrics.Min)
+			assert.Equal(t, 3000.0, metrics.Max)
+			assert.Equal(t, 2000.0, metrics.Mean)
+		}
+
+		// Raw records are capped per collection, oldest first
+		capped, err := s.HealthMonitoring().GetDailySummary(ctx, &health.DailySummaryRequest{UserId: userID, IncludeRecords: true, MaxRecords: 1})
+		assert.NoError(t, err)
+		assert.Len(t, capped.MedicalRecords, 1)
+		if assert.Len(t, capped.WearableData, 1) {
+			assert.Equal(t, summary.WearableData[0].Id, capped.WearableData[0].Id)
+		}
+		assert.True(t, capped.RecordsTruncated)
+		assert.Equal(t, summary.TypeCounts, capped.TypeCounts, "Aggregates should cover all records")
+		_, err = s.HealthMonitoring().GetDailySummary(ctx, &health.DailySummaryRequest{UserId: userID, IncludeRecords: true, MaxRecords: -1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		weekly, err := s.HealthMonitoring().GetWeeklySummary(ctx, &health.WeeklySummaryRequest{
+			UserId: userID, StartDate: "2024-01-01", EndDate: "2024-01-07",
+		})
+		assert.NoError(t, err)
+		assert.Len(t, weekly.DailyBuckets, 7)
+		assert.Empty(t, weekly.TypeCounts)
+		_, err = s.HealthMonitoring().GetWeeklySummary(ctx, &health.WeeklySummaryRequest{
+			UserId: userID, StartDate: "2024-01-07", EndDate: "2024-01-01",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Spans are bounded, as every day gets a bucket
+		weekly, err = s.HealthMonitoring().GetWeeklySummary(ctx, &health.WeeklySummaryRequest{
+			UserId: userID, StartDate: "2024-01-01", EndDate: "2024-12-31",
+		})
+		assert.NoError(t, err)
+		assert.Len(t, weekly.DailyBuckets, storage.MaxSummaryDays)
+		_, err = s.HealthMonitoring().GetWeeklySummary(ctx, &health.WeeklySummaryRequest{
+			UserId: userID, StartDate: "2024-01-01", EndDate: "2025-01-01",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = s.HealthMonitoring().GetWeeklySummary(ctx, &health.WeeklySummaryRequest{
+			UserId: userID, StartDate: "0001-01-01", EndDate: "9999-12-31",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("AuditLog", func(t *testing.T) {
+		repo := s.AuditLog()
+		userID := uuid.NewString()
+		for _, ts := range []string{"2024-05-01T08:00:00Z", "2024-05-02T08:00:00Z", "2024-05-03T08:00:00Z"} {
+			assert.NoError(t, repo.AppendAuditEntry(ctx, &health.AuditEntry{
+				Actor: "doctor-1", Action: "read", ResourceType: "medical_record", UserId: userID, Timestamp: ts,
+			}))
+		}
+
+		response, err := repo.QueryAuditLog(ctx, &health.QueryAuditLogRequest{UserId: userID, From: "2024-05-02T00:00:00Z"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), response.TotalSize)
+		if assert.Len(t, response.Entries, 2) {
+			assert.Equal(t, "2024-05-03T08:00:00Z", response.Entries[0].Timestamp, "Entries should be newest first")
+		}
+	})
+
+	t.Run("Retention", func(t *testing.T) {
+		repo := s.HealthRecommendation()
+		deleted, err := repo.CreateHealthRecommendation(ctx, &health.HealthRecommendation{UserId: uuid.NewString(), RecommendationType: "sleep", Priority: 1})
+		assert.NoError(t, err)
+		assert.NoError(t, repo.DeleteHealthRecommendation(ctx, deleted.Id))
+
+		purged, err := s.Retention().PurgeDeleted(ctx, time.Now().Add(-time.Hour))
+		assert.NoError(t, err)
+		_, err = repo.RestoreHealthRecommendation(ctx, deleted.Id)
+		assert.NoError(t, err, "Recently deleted entities should be kept")
+		assert.NoError(t, repo.DeleteHealthRecommendation(ctx, deleted.Id))
+
+		purged, err = s.Retention().PurgeDeleted(ctx, time.Now().Add(time.Minute))
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, purged, int64(1))
+		_, err = repo.RestoreHealthRecommendation(ctx, deleted.Id)
+		assert.Equal(t, codes.NotFound, status.Code(err), "Purged entities should be gone")
+	})
+}
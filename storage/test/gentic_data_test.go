@@ -15,7 +15,9 @@ import (
 
 func TestGeneticDataRepo(t *testing.T) {
 	db := createMongoDBConnection(t)
-	geneticDataRepo := mongodb.NewGeneticDataRepo(db)
+	cipher, err := newTestCipher(db)
+	assert.NoError(t, err)
+	geneticDataRepo := mongodb.NewGeneticDataRepo(db, cipher)
 
 	t.Run("CreateGeneticData", func(t *testing.T) {
 		// Create a sample Any proto message
@@ -33,7 +35,8 @@ func TestGeneticDataRepo(t *testing.T) {
 			DataValue:    dataValue,
 			AnalysisDate: time.Now().Format("2006-01-02"),
 		}
-		createdID, err := geneticDataRepo.CreateGeneticData(context.Background(), testGeneticData)
+		created, err := geneticDataRepo.CreateGeneticData(context.Background(), testGeneticData)
+		createdID := created.GetId()
 
 		assert.NoError(t, err, "CreateGeneticData should not return an error")
 		assert.NotEmpty(t, createdID, "Created genetic data should have a valid ID")
@@ -55,7 +58,8 @@ func TestGeneticDataRepo(t *testing.T) {
 			DataValue:    dataValue,
 			AnalysisDate: time.Now().Format("2006-01-02"),
 		}
-		createdID, err := geneticDataRepo.CreateGeneticData(context.Background(), testGeneticData)
+		created, err := geneticDataRepo.CreateGeneticData(context.Background(), testGeneticData)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating genetic data for GetGeneticData test failed")
 		assert.NotEmpty(t, createdID, "Created genetic data should have a valid ID")
 
@@ -88,7 +92,8 @@ func TestGeneticDataRepo(t *testing.T) {
 			DataValue:    dataValue,
 			AnalysisDate: time.Now().Format("2006-01-02"),
 		}
-		createdID, err := geneticDataRepo.CreateGeneticData(context.Background(), testGeneticData)
+		created, err := geneticDataRepo.CreateGeneticData(context.Background(), testGeneticData)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating genetic data for UpdateGeneticData test failed")
 		assert.NotEmpty(t, createdID, "Created genetic data should have a valid ID")
 
@@ -108,8 +113,9 @@ func TestGeneticDataRepo(t *testing.T) {
 			DataValue:    updatedDataValue,
 			AnalysisDate: time.Now().Add(time.Hour * 24).Format("2006-01-02"),
 		}
-		err = geneticDataRepo.UpdateGeneticData(context.Background(), updateRecord)
+		updated, err := geneticDataRepo.UpdateGeneticData(context.Background(), updateRecord)
 		assert.NoError(t, err, "UpdateGeneticData should not return an error")
+		assert.Equal(t, createdID, updated.GetId(), "Update should return the updated resource")
 
 		// 3. Retrieve the record and verify the update
 		retrievedRecord, err := geneticDataRepo.GetGeneticData(context.Background(), createdID)
@@ -137,7 +143,8 @@ func TestGeneticDataRepo(t *testing.T) {
 			DataValue:    dataValue,
 			AnalysisDate: time.Now().Format("2006-01-02"),
 		}
-		createdID, err := geneticDataRepo.CreateGeneticData(context.Background(), testGeneticData)
+		created, err := geneticDataRepo.CreateGeneticData(context.Background(), testGeneticData)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating genetic data for DeleteGeneticData test failed")
 		assert.NotEmpty(t, createdID, "Created genetic data should have a valid ID")
 
@@ -178,7 +185,8 @@ func TestGeneticDataRepo(t *testing.T) {
 		req := &health.ListGeneticDataRequest{
 			UserId: userID,
 		}
-		retrievedRecords, err := geneticDataRepo.ListGeneticData(context.Background(), req)
+		retrievedRecordsPage, err := geneticDataRepo.ListGeneticData(context.Background(), req)
+		retrievedRecords := retrievedRecordsPage.GetGeneticData()
 		assert.NoError(t, err, "ListGeneticData should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListGeneticData response should not be nil")
 		assert.GreaterOrEqual(t, len(retrievedRecords), 2, "Should have at least two genetic data records for the user")
@@ -188,7 +196,8 @@ func TestGeneticDataRepo(t *testing.T) {
 			UserId:   userID,
 			DataType: "DNA Sequencing 1",
 		}
-		retrievedRecords, err = geneticDataRepo.ListGeneticData(context.Background(), req)
+		retrievedRecordsPage, err = geneticDataRepo.ListGeneticData(context.Background(), req)
+		retrievedRecords = retrievedRecordsPage.GetGeneticData()
 		assert.NoError(t, err, "ListGeneticData should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListGeneticData response should not be nil")
 		assert.Equal(t, 1, len(retrievedRecords), "Should have one genetic data record matching the filter")
@@ -199,7 +208,8 @@ func TestGeneticDataRepo(t *testing.T) {
 			UserId:       userID,
 			AnalysisDate: time.Now().Format("2006-01-02"),
 		}
-		retrievedRecords, err = geneticDataRepo.ListGeneticData(context.Background(), req)
+		retrievedRecordsPage, err = geneticDataRepo.ListGeneticData(context.Background(), req)
+		retrievedRecords = retrievedRecordsPage.GetGeneticData()
 		assert.NoError(t, err, "ListGeneticData should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListGeneticData response should not be nil")
 		assert.Equal(t, 1, len(retrievedRecords), "Should have one genetic data record matching the filter")
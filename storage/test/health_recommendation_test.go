@@ -2,12 +2,16 @@ package test
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
 	mongodb "github.com/health-analytics-service/health-analytics-service/storage/mongo"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestHealthRecommendationRepo(t *testing.T) {
@@ -21,7 +25,8 @@ func TestHealthRecommendationRepo(t *testing.T) {
 			Description:        "Engage in at least 30 minutes of moderate-intensity exercise most days of the week.",
 			Priority:           2,
 		}
-		createdID, err := healthRecommendationRepo.CreateHealthRecommendation(context.Background(), testRecommendation)
+		created, err := healthRecommendationRepo.CreateHealthRecommendation(context.Background(), testRecommendation)
+		createdID := created.GetId()
 
 		assert.NoError(t, err, "CreateHealthRecommendation should not return an error")
 		assert.NotEmpty(t, createdID, "Created health recommendation should have a valid ID")
@@ -35,7 +40,8 @@ func TestHealthRecommendationRepo(t *testing.T) {
 			Description:        "Consume a balanced diet rich in fruits, vegetables, and whole grains.",
 			Priority:           1,
 		}
-		createdID, err := healthRecommendationRepo.CreateHealthRecommendation(context.Background(), testRecommendation)
+		created, err := healthRecommendationRepo.CreateHealthRecommendation(context.Background(), testRecommendation)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating health recommendation for GetHealthRecommendation test failed")
 		assert.NotEmpty(t, createdID, "Created health recommendation should have a valid ID")
 
@@ -58,7 +64,8 @@ func TestHealthRecommendationRepo(t *testing.T) {
 			Description:        "Aim for 7-9 hours of quality sleep per night.",
 			Priority:           3,
 		}
-		createdID, err := healthRecommendationRepo.CreateHealthRecommendation(context.Background(), testRecommendation)
+		created, err := healthRecommendationRepo.CreateHealthRecommendation(context.Background(), testRecommendation)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating health recommendation for UpdateHealthRecommendation test failed")
 		assert.NotEmpty(t, createdID, "Created health recommendation should have a valid ID")
 
@@ -70,8 +77,9 @@ func TestHealthRecommendationRepo(t *testing.T) {
 			Description:        "Get at least 8 hours of sleep.",
 			Priority:           1,
 		}
-		err = healthRecommendationRepo.UpdateHealthRecommendation(context.Background(), updateRecommendation)
+		updated, err := healthRecommendationRepo.UpdateHealthRecommendation(context.Background(), updateRecommendation)
 		assert.NoError(t, err, "UpdateHealthRecommendation should not return an error")
+		assert.Equal(t, createdID, updated.GetId(), "Update should return the updated resource")
 
 		// 3. Retrieve the recommendation and verify the update
 		retrievedRecommendation, err := healthRecommendationRepo.GetHealthRecommendation(context.Background(), createdID)
@@ -82,6 +90,73 @@ func TestHealthRecommendationRepo(t *testing.T) {
 		assert.Equal(t, updateRecommendation.Priority, retrievedRecommendation.Priority, "Priority should be updated")
 	})
 
+	t.Run("OptimisticConcurrency", func(t *testing.T) {
+		// 1. Create a recommendation at version 1
+		recommendation := &health.HealthRecommendation{
+			UserId:             uuid.NewString(),
+			RecommendationType: "Exercise",
+			Description:        "Walk 30 minutes a day.",
+			Priority:           3,
+		}
+		created, err := healthRecommendationRepo.CreateHealthRecommendation(context.Background(), recommendation)
+		assert.NoError(t, err)
+		defer healthRecommendationRepo.DeleteHealthRecommendation(context.Background(), created.Id)
+		assert.Equal(t, int64(1), created.Version, "New recommendations should be at version 1")
+
+		// 2. An update of the current version succeeds, a second one of the same version is stale
+		recommendation.Id = created.Id
+		recommendation.ExpectedVersion = 1
+		updated, err := healthRecommendationRepo.UpdateHealthRecommendation(context.Background(), recommendation)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), updated.Version, "Updates should increment the version")
+
+		_, err = healthRecommendationRepo.UpdateHealthRecommendation(context.Background(), recommendation)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Stale updates should be rejected")
+
+		// 3. Concurrent updates without expected version are all applied
+		recommendation.ExpectedVersion = 0
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := healthRecommendationRepo.UpdateHealthRecommendation(context.Background(), recommendation)
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		retrieved, err := healthRecommendationRepo.GetHealthRecommendation(context.Background(), created.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), retrieved.Version, "No update should be lost")
+	})
+
+	t.Run("PartialUpdate", func(t *testing.T) {
+		// 1. Create a recommendation to update
+		created, err := healthRecommendationRepo.CreateHealthRecommendation(context.Background(), &health.HealthRecommendation{
+			UserId:             uuid.NewString(),
+			RecommendationType: "Sleep",
+			Description:        "Sleep 8 hours a night.",
+			Priority:           2,
+		})
+		assert.NoError(t, err)
+		defer healthRecommendationRepo.DeleteHealthRecommendation(context.Background(), created.Id)
+
+		// 2. Update only the priority
+		updated, err := healthRecommendationRepo.UpdateHealthRecommendation(context.Background(), &health.HealthRecommendation{
+			Id:         created.Id,
+			Priority:   5,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"priority"}},
+		})
+		assert.NoError(t, err)
+
+		// 3. The fields outside of the mask should be kept
+		assert.Equal(t, int32(5), updated.Priority, "Priority should be updated")
+		assert.Equal(t, created.UserId, updated.UserId, "UserId should be kept")
+		assert.Equal(t, created.RecommendationType, updated.RecommendationType, "RecommendationType should be kept")
+		assert.Equal(t, created.Description, updated.Description, "Description should be kept")
+	})
+
 	t.Run("DeleteHealthRecommendation", func(t *testing.T) {
 		// 1. Create a recommendation to delete
 		testRecommendation := &health.HealthRecommendation{
@@ -90,7 +165,8 @@ func TestHealthRecommendationRepo(t *testing.T) {
 			Description:        "Drink plenty of water throughout the day.",
 			Priority:           2,
 		}
-		createdID, err := healthRecommendationRepo.CreateHealthRecommendation(context.Background(), testRecommendation)
+		created, err := healthRecommendationRepo.CreateHealthRecommendation(context.Background(), testRecommendation)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating health recommendation for DeleteHealthRecommendation test failed")
 		assert.NotEmpty(t, createdID, "Created health recommendation should have a valid ID")
 
@@ -131,7 +207,8 @@ func TestHealthRecommendationRepo(t *testing.T) {
 		req := &health.ListHealthRecommendationsRequest{
 			UserId: userID,
 		}
-		retrievedRecommendations, err := healthRecommendationRepo.ListHealthRecommendations(context.Background(), req)
+		retrievedRecommendationsPage, err := healthRecommendationRepo.ListHealthRecommendations(context.Background(), req)
+		retrievedRecommendations := retrievedRecommendationsPage.GetHealthRecommendations()
 		assert.NoError(t, err, "ListHealthRecommendations should not return an error")
 		assert.NotNil(t, retrievedRecommendations, "ListHealthRecommendations response should not be nil")
 		assert.GreaterOrEqual(t, len(retrievedRecommendations), 2, "Should have at least two health recommendations for the user")
@@ -141,7 +218,8 @@ func TestHealthRecommendationRepo(t *testing.T) {
 			UserId:             userID,
 			RecommendationType: "Exercise 1",
 		}
-		retrievedRecommendations, err = healthRecommendationRepo.ListHealthRecommendations(context.Background(), req)
+		retrievedRecommendationsPage, err = healthRecommendationRepo.ListHealthRecommendations(context.Background(), req)
+		retrievedRecommendations = retrievedRecommendationsPage.GetHealthRecommendations()
 		assert.NoError(t, err, "ListHealthRecommendations should not return an error")
 		assert.NotNil(t, retrievedRecommendations, "ListHealthRecommendations response should not be nil")
 		assert.Equal(t, 1, len(retrievedRecommendations), "Should have one health recommendation matching the filter")
@@ -152,7 +230,8 @@ func TestHealthRecommendationRepo(t *testing.T) {
 			UserId:   userID,
 			Priority: 2,
 		}
-		retrievedRecommendations, err = healthRecommendationRepo.ListHealthRecommendations(context.Background(), req)
+		retrievedRecommendationsPage, err = healthRecommendationRepo.ListHealthRecommendations(context.Background(), req)
+		retrievedRecommendations = retrievedRecommendationsPage.GetHealthRecommendations()
 		assert.NoError(t, err, "ListHealthRecommendations should not return an error")
 		assert.NotNil(t, retrievedRecommendations, "ListHealthRecommendations response should not be nil")
 		assert.Equal(t, 1, len(retrievedRecommendations), "Should have one health recommendation matching the filter")
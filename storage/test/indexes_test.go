@@ -0,0 +1,62 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	mongodb "github.com/health-analytics-service/health-analytics-service/storage/mongo"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestEnsureIndexes(t *testing.T) {
+	ctx := context.Background()
+
+	// Use a database of its own so that drift of the shared one does not interfere
+	db := createMongoDBConnection(t).Client().Database("indexes_" + strings.ReplaceAll(uuid.NewString(), "-", ""))
+	defer db.Drop(ctx)
+
+	// 1. The first run creates all indexes, even of collections that do not exist yet
+	report, err := mongodb.EnsureIndexes(ctx, db)
+	assert.NoError(t, err)
+	assert.Contains(t, report.Created, "wearable_data.user_id_1_data_type_1_recorded_timestamp_-1")
+	assert.Contains(t, report.Created, "medical_records.user_id_1_created_at_-1__id_-1")
+	assert.Contains(t, report.Created, "medical_records_history.record_id_1_version_-1")
+	assert.Empty(t, report.Drift)
+
+	// 2. Running again changes nothing
+	report, err = mongodb.EnsureIndexes(ctx, db)
+	assert.NoError(t, err)
+	assert.Empty(t, report.Created)
+	assert.Empty(t, report.Drift)
+
+	// 3. Undeclared indexes and declared keys under another name are reported, not dropped
+	_, err = db.Collection("genetic_data").Indexes().CreateOne(ctx, mongo.IndexModel{Keys: bson.D{{Key: "data_type", Value: 1}}})
+	assert.NoError(t, err)
+	_, err = db.Collection("data_keys").Indexes().DropOne(ctx, "user_id_1_version_-1")
+	assert.NoError(t, err)
+	_, err = db.Collection("data_keys").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "version", Value: -1}},
+		Options: options.Index().SetName("latest_key"),
+	})
+	assert.NoError(t, err)
+
+	report, err = mongodb.EnsureIndexes(ctx, db)
+	assert.NoError(t, err)
+	assert.Empty(t, report.Created)
+	assert.ElementsMatch(t, []mongodb.IndexDrift{
+		{Collection: "data_keys", Index: "latest_key", Reason: "declared as user_id_1_version_-1"},
+		{Collection: "genetic_data", Index: "data_type_1", Reason: "not declared"},
+	}, report.Drift)
+
+	// 4. Dropped declared indexes are recreated
+	_, err = db.Collection("wearable_data").Indexes().DropOne(ctx, "user_id_1_data_type_1_recorded_timestamp_-1")
+	assert.NoError(t, err)
+	report, err = mongodb.EnsureIndexes(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"wearable_data.user_id_1_data_type_1_recorded_timestamp_-1"}, report.Created)
+}
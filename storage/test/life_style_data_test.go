@@ -32,7 +32,8 @@ func TestLifestyleDataRepo(t *testing.T) {
 			DataValue:    dataValue,
 			RecordedDate: time.Now().Format("2006-01-02"),
 		}
-		createdID, err := lifestyleDataRepo.CreateLifestyleData(context.Background(), testLifestyleData)
+		created, err := lifestyleDataRepo.CreateLifestyleData(context.Background(), testLifestyleData)
+		createdID := created.GetId()
 
 		assert.NoError(t, err, "CreateLifestyleData should not return an error")
 		assert.NotEmpty(t, createdID, "Created lifestyle data should have a valid ID")
@@ -54,7 +55,8 @@ func TestLifestyleDataRepo(t *testing.T) {
 			DataValue:    dataValue,
 			RecordedDate: time.Now().Format("2006-01-02"),
 		}
-		createdID, err := lifestyleDataRepo.CreateLifestyleData(context.Background(), testLifestyleData)
+		created, err := lifestyleDataRepo.CreateLifestyleData(context.Background(), testLifestyleData)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating lifestyle data for GetLifestyleData test failed")
 		assert.NotEmpty(t, createdID, "Created lifestyle data should have a valid ID")
 
@@ -86,7 +88,8 @@ func TestLifestyleDataRepo(t *testing.T) {
 			DataValue:    dataValue,
 			RecordedDate: time.Now().Format("2006-01-02"),
 		}
-		createdID, err := lifestyleDataRepo.CreateLifestyleData(context.Background(), testLifestyleData)
+		created, err := lifestyleDataRepo.CreateLifestyleData(context.Background(), testLifestyleData)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating lifestyle data for UpdateLifestyleData test failed")
 		assert.NotEmpty(t, createdID, "Created lifestyle data should have a valid ID")
 
@@ -106,8 +109,9 @@ func TestLifestyleDataRepo(t *testing.T) {
 			DataValue:    updatedDataValue,
 			RecordedDate: time.Now().Add(time.Hour * 24).Format("2006-01-02"),
 		}
-		err = lifestyleDataRepo.UpdateLifestyleData(context.Background(), updateRecord)
+		updated, err := lifestyleDataRepo.UpdateLifestyleData(context.Background(), updateRecord)
 		assert.NoError(t, err, "UpdateLifestyleData should not return an error")
+		assert.Equal(t, createdID, updated.GetId(), "Update should return the updated resource")
 
 		// 3. Retrieve the record and verify the update
 		retrievedRecord, err := lifestyleDataRepo.GetLifestyleData(context.Background(), createdID)
@@ -135,7 +139,8 @@ func TestLifestyleDataRepo(t *testing.T) {
 			DataValue:    dataValue,
 			RecordedDate: time.Now().Format("2006-01-02"),
 		}
-		createdID, err := lifestyleDataRepo.CreateLifestyleData(context.Background(), testLifestyleData)
+		created, err := lifestyleDataRepo.CreateLifestyleData(context.Background(), testLifestyleData)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating lifestyle data for DeleteLifestyleData test failed")
 		assert.NotEmpty(t, createdID, "Created lifestyle data should have a valid ID")
 
@@ -176,7 +181,8 @@ func TestLifestyleDataRepo(t *testing.T) {
 		req := &health.ListLifestyleDataRequest{
 			UserId: userID,
 		}
-		retrievedRecords, err := lifestyleDataRepo.ListLifestyleData(context.Background(), req)
+		retrievedRecordsPage, err := lifestyleDataRepo.ListLifestyleData(context.Background(), req)
+		retrievedRecords := retrievedRecordsPage.GetLifestyleData()
 		assert.NoError(t, err, "ListLifestyleData should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListLifestyleData response should not be nil")
 		assert.GreaterOrEqual(t, len(retrievedRecords), 2, "Should have at least two lifestyle data records for the user")
@@ -186,7 +192,8 @@ func TestLifestyleDataRepo(t *testing.T) {
 			UserId:   userID,
 			DataType: "Sleep 1",
 		}
-		retrievedRecords, err = lifestyleDataRepo.ListLifestyleData(context.Background(), req)
+		retrievedRecordsPage, err = lifestyleDataRepo.ListLifestyleData(context.Background(), req)
+		retrievedRecords = retrievedRecordsPage.GetLifestyleData()
 		assert.NoError(t, err, "ListLifestyleData should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListLifestyleData response should not be nil")
 		assert.Equal(t, 1, len(retrievedRecords), "Should have one lifestyle data record matching the filter")
@@ -197,7 +204,8 @@ func TestLifestyleDataRepo(t *testing.T) {
 			UserId:       userID,
 			RecordedDate: time.Now().Format("2006-01-02"),
 		}
-		retrievedRecords, err = lifestyleDataRepo.ListLifestyleData(context.Background(), req)
+		retrievedRecordsPage, err = lifestyleDataRepo.ListLifestyleData(context.Background(), req)
+		retrievedRecords = retrievedRecordsPage.GetLifestyleData()
 		assert.NoError(t, err, "ListLifestyleData should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListLifestyleData response should not be nil")
 		assert.Equal(t, 1, len(retrievedRecords), "Should have one lifestyle data record matching the filter")
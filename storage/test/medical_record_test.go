@@ -6,14 +6,23 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/health-analytics-service/health-analytics-service/encryption"
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
 	mongodb "github.com/health-analytics-service/health-analytics-service/storage/mongo"
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestMedicalRecordRepo(t *testing.T) {
 	db := createMongoDBConnection(t)
-	medicalRecordRepo := mongodb.NewMedicalRecordRepo(db)
+	cipher, err := newTestCipher(db)
+	assert.NoError(t, err)
+	medicalRecordRepo := mongodb.NewMedicalRecordRepo(db, cipher)
 
 	t.Run("CreateMedicalRecord", func(t *testing.T) {
 		testRecord := &health.MedicalRecord{
@@ -24,10 +33,13 @@ func TestMedicalRecordRepo(t *testing.T) {
 			DoctorId:    uuid.NewString(),
 			Attachments: []string{"attachment1.txt", "attachment2.pdf"},
 		}
-		createdID, err := medicalRecordRepo.CreateMedicalRecord(context.Background(), testRecord)
+		created, err := medicalRecordRepo.CreateMedicalRecord(context.Background(), testRecord)
+		createdID := created.GetId()
 
 		assert.NoError(t, err, "CreateMedicalRecord should not return an error")
 		assert.NotEmpty(t, createdID, "Created medical record should have a valid ID")
+		assert.NotEmpty(t, created.GetCreatedAt(), "Created medical record should have created_at set")
+		assert.Equal(t, testRecord.Description, created.GetDescription(), "Create should return the persisted record")
 	})
 
 	t.Run("GetMedicalRecord", func(t *testing.T) {
@@ -40,7 +52,8 @@ func TestMedicalRecordRepo(t *testing.T) {
 			DoctorId:    uuid.NewString(),
 			Attachments: []string{"attachment1.txt", "attachment2.pdf"},
 		}
-		createdID, err := medicalRecordRepo.CreateMedicalRecord(context.Background(), testRecord)
+		created, err := medicalRecordRepo.CreateMedicalRecord(context.Background(), testRecord)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating medical record for GetMedicalRecord test failed")
 		assert.NotEmpty(t, createdID, "Created medical record should have a valid ID")
 
@@ -67,7 +80,8 @@ func TestMedicalRecordRepo(t *testing.T) {
 			DoctorId:    uuid.NewString(),
 			Attachments: []string{"attachment1.txt", "attachment2.pdf"},
 		}
-		createdID, err := medicalRecordRepo.CreateMedicalRecord(context.Background(), testRecord)
+		created, err := medicalRecordRepo.CreateMedicalRecord(context.Background(), testRecord)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating medical record for UpdateMedicalRecord test failed")
 		assert.NotEmpty(t, createdID, "Created medical record should have a valid ID")
 
@@ -78,8 +92,9 @@ func TestMedicalRecordRepo(t *testing.T) {
 			RecordType:  "Updated Record Type",
 			Description: "Updated description.",
 		}
-		err = medicalRecordRepo.UpdateMedicalRecord(context.Background(), updateRecord)
+		updated, err := medicalRecordRepo.UpdateMedicalRecord(context.Background(), updateRecord)
 		assert.NoError(t, err, "UpdateMedicalRecord should not return an error")
+		assert.Equal(t, createdID, updated.GetId(), "Update should return the updated resource")
 
 		// 3. Retrieve the record and verify the update
 		retrievedRecord, err := medicalRecordRepo.GetMedicalRecord(context.Background(), createdID)
@@ -103,7 +118,8 @@ func TestMedicalRecordRepo(t *testing.T) {
 			DoctorId:    uuid.NewString(),
 			Attachments: []string{"attachment1.txt", "attachment2.pdf"},
 		}
-		createdID, err := medicalRecordRepo.CreateMedicalRecord(context.Background(), testRecord)
+		created, err := medicalRecordRepo.CreateMedicalRecord(context.Background(), testRecord)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating medical record for DeleteMedicalRecord test failed")
 		assert.NotEmpty(t, createdID, "Created medical record should have a valid ID")
 
@@ -117,6 +133,235 @@ func TestMedicalRecordRepo(t *testing.T) {
 		assert.Nil(t, retrievedRecord, "GetMedicalRecord response should be nil after delete")
 	})
 
+	t.Run("Versions", func(t *testing.T) {
+		// 1. Create a record and update it twice
+		created, err := medicalRecordRepo.CreateMedicalRecord(context.Background(), &health.MedicalRecord{
+			UserId:      uuid.NewString(),
+			RecordType:  "Diagnosis",
+			RecordDate:  "2024-03-01",
+			Description: "Revision 1",
+		})
+		assert.NoError(t, err)
+		defer medicalRecordRepo.DeleteMedicalRecord(context.Background(), created.Id)
+		assert.Equal(t, int64(1), created.Version, "New records should be at version 1")
+
+		updated, err := medicalRecordRepo.UpdateMedicalRecord(context.Background(), &health.MedicalRecord{
+			Id:              created.Id,
+			Description:     "Revision 2",
+			ExpectedVersion: 1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), updated.Version, "Updates should increment the version")
+
+		updated, err = medicalRecordRepo.UpdateMedicalRecord(context.Background(), &health.MedicalRecord{
+			Id:          created.Id,
+			Description: "Revision 3",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), updated.Version)
+
+		// 2. Updates of an outdated version are rejected
+		_, err = medicalRecordRepo.UpdateMedicalRecord(context.Background(), &health.MedicalRecord{
+			Id:              created.Id,
+			Description:     "Stale",
+			ExpectedVersion: 2,
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Stale updates should be rejected")
+
+		// 3. Page through the prior revisions, newest first
+		firstPage, err := medicalRecordRepo.ListMedicalRecordVersions(context.Background(), &health.ListMedicalRecordVersionsRequest{
+			Id:       created.Id,
+			PageSize: 1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), firstPage.TotalSize, "Both prior revisions should be kept")
+		assert.Len(t, firstPage.Versions, 1)
+		assert.Equal(t, int64(2), firstPage.Versions[0].Version)
+		assert.Equal(t, "Revision 2", firstPage.Versions[0].Description)
+		assert.Equal(t, created.Id, firstPage.Versions[0].Id)
+		assert.NotEmpty(t, firstPage.NextPageToken)
+
+		secondPage, err := medicalRecordRepo.ListMedicalRecordVersions(context.Background(), &health.ListMedicalRecordVersionsRequest{
+			Id:        created.Id,
+			PageSize:  1,
+			PageToken: firstPage.NextPageToken,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, secondPage.Versions, 1)
+		assert.Equal(t, "Revision 1", secondPage.Versions[0].Description)
+		assert.Empty(t, secondPage.NextPageToken)
+
+		// 4. Get single revisions
+		version, err := medicalRecordRepo.GetMedicalRecordVersion(context.Background(), created.Id, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "Revision 1", version.Description)
+		version, err = medicalRecordRepo.GetMedicalRecordVersion(context.Background(), created.Id, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, "Revision 3", version.Description, "The current version should be returned from the record")
+		_, err = medicalRecordRepo.GetMedicalRecordVersion(context.Background(), created.Id, 4)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("ArchiveFailure", func(t *testing.T) {
+		created, err := medicalRecordRepo.CreateMedicalRecord(context.Background(), &health.MedicalRecord{
+			UserId:      uuid.NewString(),
+			RecordType:  "Diagnosis",
+			RecordDate:  "2024-03-01",
+			Description: "Revision 1",
+		})
+		assert.NoError(t, err)
+		defer medicalRecordRepo.DeleteMedicalRecord(context.Background(), created.Id)
+
+		// 1. Reject every write to the history
+		db.CreateCollection(context.Background(), "medical_records_history") // Exists unless run alone
+		setHistoryValidator := func(validator bson.M) {
+			err := db.RunCommand(context.Background(), bson.D{
+				{Key: "collMod", Value: "medical_records_history"},
+				{Key: "validator", Value: validator},
+			}).Err()
+			assert.NoError(t, err)
+		}
+		setHistoryValidator(bson.M{"$expr": false})
+		defer setHistoryValidator(bson.M{})
+
+		// 2. The update fails without being applied
+		_, err = medicalRecordRepo.UpdateMedicalRecord(context.Background(), &health.MedicalRecord{
+			Id:              created.Id,
+			Description:     "Revision 2",
+			ExpectedVersion: 1,
+		})
+		assert.Error(t, err, "Updates should fail when their revision cannot be archived")
+
+		current, err := medicalRecordRepo.GetMedicalRecord(context.Background(), created.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), current.Version)
+		assert.Equal(t, "Revision 1", current.Description)
+		versions, err := medicalRecordRepo.ListMedicalRecordVersions(context.Background(), &health.ListMedicalRecordVersionsRequest{Id: created.Id})
+		assert.NoError(t, err)
+		assert.Zero(t, versions.TotalSize)
+
+		// 3. Retrying once the history accepts writes applies the update
+		setHistoryValidator(bson.M{})
+		updated, err := medicalRecordRepo.UpdateMedicalRecord(context.Background(), &health.MedicalRecord{
+			Id:              created.Id,
+			Description:     "Revision 2",
+			ExpectedVersion: 1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), updated.Version)
+
+		versions, err = medicalRecordRepo.ListMedicalRecordVersions(context.Background(), &health.ListMedicalRecordVersionsRequest{Id: created.Id})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), versions.TotalSize)
+		if assert.Len(t, versions.Versions, 1) {
+			assert.Equal(t, "Revision 1", versions.Versions[0].Description)
+		}
+	})
+
+	t.Run("UnappliedArchive", func(t *testing.T) {
+		created, err := medicalRecordRepo.CreateMedicalRecord(context.Background(), &health.MedicalRecord{
+			UserId:      uuid.NewString(),
+			RecordType:  "Diagnosis",
+			RecordDate:  "2024-03-01",
+			Description: "Revision 1",
+		})
+		assert.NoError(t, err)
+		defer medicalRecordRepo.DeleteMedicalRecord(context.Background(), created.Id)
+
+		// A revision archived by an update that did not apply is at the current version
+		objID, _ := primitive.ObjectIDFromHex(created.Id)
+		_, err = db.Collection("medical_records_history").InsertOne(context.Background(), bson.M{
+			"record_id": objID,
+			"version":   int64(1),
+			"user_id":   created.UserId,
+		})
+		assert.NoError(t, err)
+
+		versions, err := medicalRecordRepo.ListMedicalRecordVersions(context.Background(), &health.ListMedicalRecordVersionsRequest{Id: created.Id})
+		assert.NoError(t, err)
+		assert.Zero(t, versions.TotalSize)
+		assert.Empty(t, versions.Versions)
+		version, err := medicalRecordRepo.GetMedicalRecordVersion(context.Background(), created.Id, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "Revision 1", version.Description, "The current version should be returned from the record")
+	})
+
+	t.Run("RestoreMedicalRecord", func(t *testing.T) {
+		// 1. Create and delete a record
+		userID := uuid.NewString()
+		created, err := medicalRecordRepo.CreateMedicalRecord(context.Background(), &health.MedicalRecord{
+			UserId:      userID,
+			RecordType:  "Test Record for Restore",
+			RecordDate:  time.Now().Format("2006-01-02"),
+			Description: "This is a test medical record for Restore.",
+		})
+		assert.NoError(t, err, "Creating medical record for RestoreMedicalRecord test failed")
+		ctx := storage.WithActor(context.Background(), "user-who-deleted")
+		assert.NoError(t, medicalRecordRepo.DeleteMedicalRecord(ctx, created.Id))
+
+		// 2. The deleted record is hidden from lists and deletes, but kept with its markers
+		listed, err := medicalRecordRepo.ListMedicalRecords(context.Background(), &health.ListMedicalRecordsRequest{UserId: userID})
+		assert.NoError(t, err)
+		assert.Empty(t, listed.MedicalRecords, "Deleted records should not be listed")
+		err = medicalRecordRepo.DeleteMedicalRecord(context.Background(), created.Id)
+		assert.Equal(t, codes.NotFound, status.Code(err), "Deleting twice should report NotFound")
+
+		objID, err := primitive.ObjectIDFromHex(created.Id)
+		assert.NoError(t, err)
+		var raw bson.M
+		err = db.Collection("medical_records").FindOne(context.Background(), bson.M{"_id": objID}).Decode(&raw)
+		assert.NoError(t, err, "Deleted record should be kept until purged")
+		assert.NotNil(t, raw["deleted_at"])
+		assert.Equal(t, "user-who-deleted", raw["deleted_by"])
+
+		deleted, err := medicalRecordRepo.GetMedicalRecord(storage.WithDeleted(context.Background()), created.Id)
+		assert.NoError(t, err, "Deleted records should be readable when explicitly included")
+		assert.Equal(t, created.Id, deleted.Id)
+
+		// 3. Restore the record
+		restored, err := medicalRecordRepo.RestoreMedicalRecord(context.Background(), created.Id)
+		assert.NoError(t, err, "RestoreMedicalRecord should not return an error")
+		assert.Equal(t, "This is a test medical record for Restore.", restored.Description)
+
+		retrieved, err := medicalRecordRepo.GetMedicalRecord(context.Background(), created.Id)
+		assert.NoError(t, err, "Restored record should be readable again")
+		assert.Equal(t, created.Id, retrieved.Id)
+
+		// 4. Records that are not deleted cannot be restored
+		_, err = medicalRecordRepo.RestoreMedicalRecord(context.Background(), created.Id)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Restoring a live record should be rejected")
+		_, err = medicalRecordRepo.RestoreMedicalRecord(context.Background(), primitive.NewObjectID().Hex())
+		assert.Equal(t, codes.NotFound, status.Code(err), "Restoring a missing record should report NotFound")
+	})
+
+	t.Run("ErrorCodes", func(t *testing.T) {
+		// 1. A malformed id is an invalid argument naming the offending field
+		_, err := medicalRecordRepo.GetMedicalRecord(context.Background(), "not-an-object-id")
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		if assert.Len(t, st.Details(), 1) {
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			assert.True(t, ok, "InvalidArgument should carry BadRequest details")
+			assert.Equal(t, "id", badRequest.GetFieldViolations()[0].GetField())
+		}
+
+		// 2. A well-formed but unknown id is not found
+		_, err = medicalRecordRepo.GetMedicalRecord(context.Background(), primitive.NewObjectID().Hex())
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		// 3. Creating a record with a taken id already exists
+		testRecord := &health.MedicalRecord{
+			Id:         primitive.NewObjectID().Hex(),
+			UserId:     uuid.NewString(),
+			RecordType: "Duplicate",
+			RecordDate: time.Now().Format("2006-01-02"),
+		}
+		_, err = medicalRecordRepo.CreateMedicalRecord(context.Background(), testRecord)
+		assert.NoError(t, err)
+		_, err = medicalRecordRepo.CreateMedicalRecord(context.Background(), testRecord)
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
 	t.Run("ListMedicalRecords", func(t *testing.T) {
 		// 1. Create some records for a specific user
 		userID := uuid.NewString()
@@ -148,7 +393,8 @@ func TestMedicalRecordRepo(t *testing.T) {
 		req := &health.ListMedicalRecordsRequest{
 			UserId: userID,
 		}
-		retrievedRecords, err := medicalRecordRepo.ListMedicalRecords(context.Background(), req)
+		retrievedRecordsPage, err := medicalRecordRepo.ListMedicalRecords(context.Background(), req)
+		retrievedRecords := retrievedRecordsPage.GetMedicalRecords()
 		assert.NoError(t, err, "ListMedicalRecords should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListMedicalRecords response should not be nil")
 		assert.GreaterOrEqual(t, len(retrievedRecords), 2, "Should have at least two medical records for the user")
@@ -158,7 +404,8 @@ func TestMedicalRecordRepo(t *testing.T) {
 			UserId:     userID,
 			RecordType: "Test Record 1 for List",
 		}
-		retrievedRecords, err = medicalRecordRepo.ListMedicalRecords(context.Background(), req)
+		retrievedRecordsPage, err = medicalRecordRepo.ListMedicalRecords(context.Background(), req)
+		retrievedRecords = retrievedRecordsPage.GetMedicalRecords()
 		assert.NoError(t, err, "ListMedicalRecords should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListMedicalRecords response should not be nil")
 		assert.Equal(t, 1, len(retrievedRecords), "Should have one medical record matching the filter")
@@ -169,7 +416,8 @@ func TestMedicalRecordRepo(t *testing.T) {
 			UserId:     userID,
 			RecordDate: time.Now().Format("2006-01-02"),
 		}
-		retrievedRecords, err = medicalRecordRepo.ListMedicalRecords(context.Background(), req)
+		retrievedRecordsPage, err = medicalRecordRepo.ListMedicalRecords(context.Background(), req)
+		retrievedRecords = retrievedRecordsPage.GetMedicalRecords()
 		assert.NoError(t, err, "ListMedicalRecords should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListMedicalRecords response should not be nil")
 		assert.Equal(t, 1, len(retrievedRecords), "Should have one medical record matching the filter")
@@ -180,7 +428,8 @@ func TestMedicalRecordRepo(t *testing.T) {
 			UserId:      userID,
 			Description: "This is test medical record 1 for List.",
 		}
-		retrievedRecords, err = medicalRecordRepo.ListMedicalRecords(context.Background(), req)
+		retrievedRecordsPage, err = medicalRecordRepo.ListMedicalRecords(context.Background(), req)
+		retrievedRecords = retrievedRecordsPage.GetMedicalRecords()
 		assert.NoError(t, err, "ListMedicalRecords should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListMedicalRecords response should not be nil")
 		assert.Equal(t, 1, len(retrievedRecords), "Should have one medical record matching the filter")
@@ -192,10 +441,84 @@ func TestMedicalRecordRepo(t *testing.T) {
 			UserId:   userID,
 			DoctorId: doctorID,
 		}
-		retrievedRecords, err = medicalRecordRepo.ListMedicalRecords(context.Background(), req)
+		retrievedRecordsPage, err = medicalRecordRepo.ListMedicalRecords(context.Background(), req)
+		retrievedRecords = retrievedRecordsPage.GetMedicalRecords()
 		assert.NoError(t, err, "ListMedicalRecords should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListMedicalRecords response should not be nil")
 		assert.Equal(t, 1, len(retrievedRecords), "Should have one medical record matching the filter")
 		assert.Equal(t, doctorID, retrievedRecords[0].DoctorId, "DoctorId should match the filter")
+
+		// 7. Test paging through the records one at a time
+		req = &health.ListMedicalRecordsRequest{
+			UserId:   userID,
+			PageSize: 1,
+			OrderBy:  "created_at asc",
+		}
+		firstPage, err := medicalRecordRepo.ListMedicalRecords(context.Background(), req)
+		assert.NoError(t, err, "ListMedicalRecords should not return an error")
+		assert.Len(t, firstPage.MedicalRecords, 1, "First page should hold a single record")
+		assert.Equal(t, int64(2), firstPage.TotalSize, "TotalSize should count all matching records")
+		assert.NotEmpty(t, firstPage.NextPageToken, "First page should have a next page token")
+
+		req.PageToken = firstPage.NextPageToken
+		secondPage, err := medicalRecordRepo.ListMedicalRecords(context.Background(), req)
+		assert.NoError(t, err, "ListMedicalRecords should not return an error")
+		assert.Len(t, secondPage.MedicalRecords, 1, "Second page should hold a single record")
+		assert.Empty(t, secondPage.NextPageToken, "Last page should not have a next page token")
+		assert.NotEqual(t, firstPage.MedicalRecords[0].Id, secondPage.MedicalRecords[0].Id, "Pages should not overlap")
+
+		// 8. Test rejecting a page token issued for another order
+		req.OrderBy = "created_at desc"
+		_, err = medicalRecordRepo.ListMedicalRecords(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "Mismatched page token should be rejected")
+	})
+
+	t.Run("Encryption", func(t *testing.T) {
+		userID := uuid.NewString()
+		created, err := medicalRecordRepo.CreateMedicalRecord(context.Background(), &health.MedicalRecord{
+			UserId:      userID,
+			RecordType:  "Diagnosis",
+			RecordDate:  "2024-03-01",
+			Description: "Chronic migraine",
+			Attachments: []string{"scan.pdf"},
+		})
+		assert.NoError(t, err)
+		defer medicalRecordRepo.DeleteMedicalRecord(context.Background(), created.Id)
+
+		// 1. The stored document holds ciphertext only
+		objID, err := primitive.ObjectIDFromHex(created.Id)
+		assert.NoError(t, err)
+		var raw bson.M
+		err = db.Collection("medical_records").FindOne(context.Background(), bson.M{"_id": objID}).Decode(&raw)
+		assert.NoError(t, err)
+		assert.True(t, encryption.IsEncrypted(raw["description"].(string)), "Description should be encrypted")
+		assert.True(t, encryption.IsEncrypted(raw["attachments"].(bson.A)[0].(string)), "Attachments should be encrypted")
+
+		// 2. Filtering by description goes through the blind index
+		records, err := medicalRecordRepo.ListMedicalRecords(context.Background(), &health.ListMedicalRecordsRequest{
+			UserId:      userID,
+			Description: "chronic migraine",
+		})
+		assert.NoError(t, err)
+		assert.Len(t, records.MedicalRecords, 1, "Description filter should match the encrypted record")
+		assert.Equal(t, "Chronic migraine", records.MedicalRecords[0].Description)
+
+		// 3. Legacy plaintext is re-encrypted with the rotated data key
+		_, err = db.Collection("medical_records").UpdateOne(context.Background(), bson.M{"_id": objID},
+			bson.M{"$set": bson.M{"description": "Chronic migraine"}, "$unset": bson.M{"description_bidx": ""}})
+		assert.NoError(t, err)
+		_, err = mongodb.ReencryptDatabase(context.Background(), db, cipher, mongodb.ReencryptOptions{RotateDataKeys: true})
+		assert.NoError(t, err)
+
+		err = db.Collection("medical_records").FindOne(context.Background(), bson.M{"_id": objID}).Decode(&raw)
+		assert.NoError(t, err)
+		stale, err := cipher.NeedsReencryption(context.Background(), userID, raw["description"].(string))
+		assert.NoError(t, err)
+		assert.False(t, stale, "Description should be encrypted with the latest data key")
+
+		retrieved, err := medicalRecordRepo.GetMedicalRecord(context.Background(), created.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, "Chronic migraine", retrieved.Description)
+		assert.Equal(t, []string{"scan.pdf"}, retrieved.Attachments)
 	})
 }
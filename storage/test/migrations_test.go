@@ -0,0 +1,200 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	mongodb "github.com/health-analytics-service/health-analytics-service/storage/mongo"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// versions returns the versions of migrations, in order.
+func versions(migrations []mongodb.Migration) []int64 {
+	var v []int64
+	for _, migration := range migrations {
+		v = append(v, migration.Version)
+	}
+	return v
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+
+	// Use a database of its own so that the applied migrations start empty
+	db := createMongoDBConnection(t).Client().Database("migrations_" + strings.ReplaceAll(uuid.NewString(), "-", ""))
+	defer db.Drop(ctx)
+
+	// Each migration records its version in a log while applied
+	var calls []string
+	step := func(name string) func(context.Context, *mongo.Database) error {
+		return func(context.Context, *mongo.Database) error {
+			calls = append(calls, name)
+			return nil
+		}
+	}
+	migrator, err := mongodb.NewMigrator(db, []mongodb.Migration{
+		{Version: 3, Name: "third", Up: step("up 3")},
+		{Version: 1, Name: "first", Up: step("up 1"), Down: step("down 1")},
+		{Version: 2, Name: "second", Up: step("up 2"), Down: step("down 2")},
+	})
+	assert.NoError(t, err)
+
+	// 1. Duplicate versions are rejected
+	_, err = mongodb.NewMigrator(db, []mongodb.Migration{
+		{Version: 1, Name: "a", Up: step("a")},
+		{Version: 1, Name: "b", Up: step("b")},
+	})
+	assert.Error(t, err)
+
+	// 2. A dry run lists the pending migrations without applying them
+	migrations, err := migrator.Up(ctx, mongodb.MigrateOptions{DryRun: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, versions(migrations))
+	assert.Empty(t, calls)
+
+	// 3. Up applies in version order, bounded by To
+	migrations, err = migrator.Up(ctx, mongodb.MigrateOptions{To: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, versions(migrations))
+	assert.Equal(t, []string{"up 1", "up 2"}, calls)
+
+	statuses, err := migrator.Status(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 3)
+	assert.False(t, statuses[1].AppliedAt.IsZero())
+	assert.True(t, statuses[2].AppliedAt.IsZero())
+
+	// 4. Running up again only applies what is still pending
+	migrations, err = migrator.Up(ctx, mongodb.MigrateOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3}, versions(migrations))
+
+	// 5. Irreversible migrations stop down before anything is reverted
+	calls = nil
+	_, err = migrator.Down(ctx, mongodb.MigrateOptions{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Empty(t, calls)
+
+	// 6. Down reverts in descending version order, bounded by To
+	_, err = db.Collection("schema_migrations").DeleteOne(ctx, bson.M{"_id": int64(3)})
+	assert.NoError(t, err)
+	migrations, err = migrator.Down(ctx, mongodb.MigrateOptions{Steps: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2}, versions(migrations))
+	migrations, err = migrator.Down(ctx, mongodb.MigrateOptions{To: 0})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1}, versions(migrations))
+	assert.Equal(t, []string{"down 2", "down 1"}, calls)
+
+	// 7. A run holding the lock makes others abort until its lease expires
+	_, err = db.Collection("schema_migrations_lock").InsertOne(ctx, bson.M{"_id": "lock", "locked_at": time.Now()})
+	assert.NoError(t, err)
+	_, err = migrator.Up(ctx, mongodb.MigrateOptions{})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	_, err = db.Collection("schema_migrations_lock").UpdateOne(ctx, bson.M{"_id": "lock"},
+		bson.M{"$set": bson.M{"locked_at": time.Now().Add(-time.Hour)}})
+	assert.NoError(t, err)
+	migrations, err = migrator.Up(ctx, mongodb.MigrateOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, versions(migrations))
+
+	// 8. A run whose lock is taken over does not record the migration it was running
+	takeover := func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection("schema_migrations_lock").UpdateOne(ctx, bson.M{"_id": "lock"},
+			bson.M{"$set": bson.M{"owner": "other", "locked_at": time.Now()}})
+		return err
+	}
+	migrator, err = mongodb.NewMigrator(db, []mongodb.Migration{{Version: 4, Name: "fourth", Up: takeover}})
+	assert.NoError(t, err)
+	migrations, err = migrator.Up(ctx, mongodb.MigrateOptions{})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	assert.Empty(t, migrations)
+	count, err := db.Collection("schema_migrations").CountDocuments(ctx, bson.M{"_id": int64(4)})
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+	count, err = db.Collection("schema_migrations_lock").CountDocuments(ctx, bson.M{"owner": "other"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count, "The lock of the other run should be kept")
+}
+
+func TestConvertStringDatesMigration(t *testing.T) {
+	ctx := context.Background()
+	db := createMongoDBConnection(t).Client().Database("migrations_" + strings.ReplaceAll(uuid.NewString(), "-", ""))
+	defer db.Drop(ctx)
+
+	migrator, err := mongodb.NewMigrator(db, mongodb.Migrations())
+	assert.NoError(t, err)
+
+	// Dates stored as strings by earlier versions
+	_, err = db.Collection("medical_records").InsertOne(ctx, bson.M{"_id": "legacy", "record_date": "2024-05-01"})
+	assert.NoError(t, err)
+	_, err = db.Collection("medical_records").InsertOne(ctx, bson.M{"_id": "offset", "record_date": "2024-05-01T23:30:00-05:00"})
+	assert.NoError(t, err)
+	_, err = db.Collection("wearable_data").InsertOne(ctx, bson.M{"_id": "legacy", "recorded_timestamp": "2024-05-01T08:30:00Z"})
+	assert.NoError(t, err)
+	_, err = db.Collection("wearable_data").InsertOne(ctx, bson.M{"_id": "precise", "recorded_timestamp": "2024-05-01T10:30:00.250+02:00"})
+	assert.NoError(t, err)
+
+	// Up converts them to dates
+	_, err = migrator.Up(ctx, mongodb.MigrateOptions{To: 1})
+	assert.NoError(t, err)
+
+	var doc bson.M
+	assert.NoError(t, db.Collection("medical_records").FindOne(ctx, bson.M{"_id": "legacy"}).Decode(&doc))
+	assert.Equal(t, primitive.NewDateTimeFromTime(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)), doc["record_date"])
+	assert.NoError(t, db.Collection("medical_records").FindOne(ctx, bson.M{"_id": "offset"}).Decode(&doc))
+	assert.Equal(t, primitive.NewDateTimeFromTime(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)), doc["record_date"],
+		"Dates should keep the day written in the string, as storage.ParseDate does")
+	assert.NoError(t, db.Collection("wearable_data").FindOne(ctx, bson.M{"_id": "precise"}).Decode(&doc))
+	assert.Equal(t, primitive.NewDateTimeFromTime(time.Date(2024, 5, 1, 8, 30, 0, 250e6, time.UTC)), doc["recorded_timestamp"])
+
+	// Down restores the strings
+	_, err = migrator.Down(ctx, mongodb.MigrateOptions{Steps: 1})
+	assert.NoError(t, err)
+
+	assert.NoError(t, db.Collection("medical_records").FindOne(ctx, bson.M{"_id": "legacy"}).Decode(&doc))
+	assert.Equal(t, "2024-05-01", doc["record_date"])
+	assert.NoError(t, db.Collection("wearable_data").FindOne(ctx, bson.M{"_id": "legacy"}).Decode(&doc))
+	assert.Equal(t, "2024-05-01T08:30:00Z", doc["recorded_timestamp"])
+	assert.NoError(t, db.Collection("wearable_data").FindOne(ctx, bson.M{"_id": "precise"}).Decode(&doc))
+	assert.Equal(t, "2024-05-01T08:30:00.250Z", doc["recorded_timestamp"])
+}
+
+func TestConvertPayloadStringsMigration(t *testing.T) {
+	ctx := context.Background()
+	db := createMongoDBConnection(t).Client().Database("migrations_" + strings.ReplaceAll(uuid.NewString(), "-", ""))
+	defer db.Drop(ctx)
+
+	migrator, err := mongodb.NewMigrator(db, mongodb.Migrations())
+	assert.NoError(t, err)
+
+	// Payloads stored as protojson strings by earlier versions
+	legacy := `{"@type":"type.googleapis.com/health.StepsData","steps":1200}`
+	_, err = db.Collection("wearable_data").InsertOne(ctx, bson.M{"_id": "legacy", "data_value": legacy})
+	assert.NoError(t, err)
+
+	// Up converts them to sub-documents that can be queried
+	_, err = migrator.Up(ctx, mongodb.MigrateOptions{To: 2})
+	assert.NoError(t, err)
+
+	count, err := db.Collection("wearable_data").CountDocuments(ctx, bson.M{"data_value.steps": bson.M{"$gte": 1000}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// Down restores the strings
+	_, err = migrator.Down(ctx, mongodb.MigrateOptions{Steps: 1})
+	assert.NoError(t, err)
+
+	var doc bson.M
+	assert.NoError(t, db.Collection("wearable_data").FindOne(ctx, bson.M{"_id": "legacy"}).Decode(&doc))
+	assert.JSONEq(t, legacy, doc["data_value"].(string))
+}
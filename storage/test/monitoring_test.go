@@ -9,12 +9,16 @@ import (
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
 	mongodb "github.com/health-analytics-service/health-analytics-service/storage/mongo"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
 func TestHealthMonitoringRepo(t *testing.T) {
 	db := createMongoDBConnection(t)
-	healthMonitoringRepo := mongodb.NewHealthMonitoringRepo(db)
+	cipher, err := newTestCipher(db)
+	assert.NoError(t, err)
+	healthMonitoringRepo := mongodb.NewHealthMonitoringRepo(db, cipher)
 
 	// Create mock data for all services
 	userID := uuid.NewString()
@@ -22,8 +26,8 @@ func TestHealthMonitoringRepo(t *testing.T) {
 	recordedTimestamp := time.Now().Format(time.RFC3339)
 
 	// Mock Medical Record
-	medicalRecordRepo := mongodb.NewMedicalRecordRepo(db)
-	_, err := medicalRecordRepo.CreateMedicalRecord(context.Background(), &health.MedicalRecord{
+	medicalRecordRepo := mongodb.NewMedicalRecordRepo(db, cipher)
+	_, err = medicalRecordRepo.CreateMedicalRecord(context.Background(), &health.MedicalRecord{
 		UserId:      userID,
 		RecordType:  "Test Record",
 		RecordDate:  recordDate,
@@ -34,7 +38,7 @@ func TestHealthMonitoringRepo(t *testing.T) {
 	assert.NoError(t, err, "Creating mock medical record failed")
 
 	// Mock Genetic Data
-	geneticDataRepo := mongodb.NewGeneticDataRepo(db)
+	geneticDataRepo := mongodb.NewGeneticDataRepo(db, cipher)
 	dataValue, err := anypb.New(&health.MedicalRecord{
 		UserId:      uuid.NewString(),
 		RecordType:  "Genetic Test",
@@ -105,6 +109,48 @@ func TestHealthMonitoringRepo(t *testing.T) {
 		assert.NotNil(t, summary, "GetDailySummary response should not be nil")
 	})
 
+	// Test GetDailySummary aggregates
+	t.Run("GetDailySummaryAggregates", func(t *testing.T) {
+		req := &health.DailySummaryRequest{
+			UserId:         userID,
+			Date:           time.Now().UTC().Format("2006-01-02"),
+			IncludeRecords: true,
+		}
+		summary, err := healthMonitoringRepo.GetDailySummary(context.Background(), req)
+		assert.NoError(t, err, "GetDailySummary should not return an error")
+		assert.Len(t, summary.MedicalRecords, 1, "raw records should be included on request")
+		assert.Len(t, summary.DailyBuckets, 1, "a daily summary should have exactly one bucket")
+		assert.Len(t, summary.TypeCounts, 5, "each collection should report one type count")
+		if assert.Len(t, summary.WearableMetrics, 1, "heart rate metrics should be aggregated") {
+			assert.Equal(t, "HeartRate", summary.WearableMetrics[0].DataType)
+			assert.Equal(t, int64(1), summary.WearableMetrics[0].Count)
+			assert.Equal(t, float64(80), summary.WearableMetrics[0].Mean)
+		}
+	})
+
+	// Test GetDailySummary with a user time zone
+	t.Run("GetDailySummaryTimeZone", func(t *testing.T) {
+		req := &health.DailySummaryRequest{
+			UserId:   userID,
+			Date:     time.Now().In(time.UTC).Format("2006-01-02"),
+			TimeZone: "UTC",
+		}
+		summary, err := healthMonitoringRepo.GetDailySummary(context.Background(), req)
+		assert.NoError(t, err, "GetDailySummary should not return an error")
+		assert.NotNil(t, summary, "GetDailySummary response should not be nil")
+	})
+
+	// Test GetDailySummary with malformed input
+	t.Run("GetDailySummaryInvalidArgument", func(t *testing.T) {
+		for _, req := range []*health.DailySummaryRequest{
+			{UserId: userID, Date: "yesterday"},
+			{UserId: userID, Date: recordDate, TimeZone: "Mars/Olympus_Mons"},
+		} {
+			_, err := healthMonitoringRepo.GetDailySummary(context.Background(), req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), "GetDailySummary should reject %v", req)
+		}
+	})
+
 	// Test GetWeeklySummary
 	t.Run("GetWeeklySummary", func(t *testing.T) {
 		startDate := time.Now().AddDate(0, 0, -7).Format("2006-01-02") // Start date one week ago
@@ -118,4 +164,15 @@ func TestHealthMonitoringRepo(t *testing.T) {
 		assert.NoError(t, err, "GetWeeklySummary should not return an error")
 		assert.NotNil(t, summary, "GetWeeklySummary response should not be nil")
 	})
+
+	// Test GetWeeklySummary with an inverted date range
+	t.Run("GetWeeklySummaryInvalidRange", func(t *testing.T) {
+		req := &health.WeeklySummaryRequest{
+			UserId:    userID,
+			StartDate: recordDate,
+			EndDate:   time.Now().AddDate(0, 0, -7).Format("2006-01-02"),
+		}
+		_, err := healthMonitoringRepo.GetWeeklySummary(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "GetWeeklySummary should reject end_date before start_date")
+	})
 }
@@ -0,0 +1,125 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/health-analytics-service/health-analytics-service/config"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	redisDB "github.com/health-analytics-service/health-analytics-service/storage/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifications(t *testing.T) {
+	cfg := config.Load()
+	redisCl, err := redisDB.Connect(&cfg)
+	if err != nil {
+		t.Fatalf("failed to connect to Redis: %v", err)
+	}
+	defer redisCl.Close()
+
+	ctx := context.Background()
+	userID := uuid.NewString()
+	for _, message := range []string{"first", "second", "third"} {
+		assert.NoError(t, redisCl.AddNotification(ctx, userID, message))
+		time.Sleep(5 * time.Millisecond) // Notifications are ordered with millisecond precision
+	}
+
+	t.Run("ListNotifications", func(t *testing.T) {
+		page, err := redisCl.ListNotifications(ctx, &health.ListNotificationsRequest{UserId: userID, PageSize: 2})
+		assert.NoError(t, err)
+		assert.Len(t, page.GetNotifications(), 2)
+		assert.Equal(t, "third", page.GetNotifications()[0].Message)
+		assert.Equal(t, "second", page.GetNotifications()[1].Message)
+		assert.False(t, page.GetNotifications()[0].Read)
+		assert.EqualValues(t, 3, page.TotalSize)
+		assert.NotEmpty(t, page.NextPageToken)
+
+		next, err := redisCl.ListNotifications(ctx, &health.ListNotificationsRequest{UserId: userID, PageSize: 2, PageToken: page.NextPageToken})
+		assert.NoError(t, err)
+		assert.Len(t, next.GetNotifications(), 1)
+		assert.Equal(t, "first", next.GetNotifications()[0].Message)
+		assert.Empty(t, next.NextPageToken)
+	})
+
+	t.Run("MarkRead", func(t *testing.T) {
+		page, err := redisCl.ListNotifications(ctx, &health.ListNotificationsRequest{UserId: userID})
+		assert.NoError(t, err)
+		assert.Len(t, page.GetNotifications(), 3)
+
+		updated, err := redisCl.MarkRead(ctx, userID, []string{page.GetNotifications()[0].Id})
+		assert.NoError(t, err)
+		assert.EqualValues(t, 1, updated)
+
+		count, err := redisCl.GetUnreadCount(ctx, userID)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, count)
+
+		unread, err := redisCl.ListNotifications(ctx, &health.ListNotificationsRequest{UserId: userID, UnreadOnly: true})
+		assert.NoError(t, err)
+		assert.Len(t, unread.GetNotifications(), 2)
+	})
+
+	t.Run("DeleteNotification", func(t *testing.T) {
+		page, err := redisCl.ListNotifications(ctx, &health.ListNotificationsRequest{UserId: userID})
+		assert.NoError(t, err)
+
+		assert.NoError(t, redisCl.DeleteNotification(ctx, userID, page.GetNotifications()[0].Id))
+		assert.Error(t, redisCl.DeleteNotification(ctx, userID, page.GetNotifications()[0].Id))
+
+		page, err = redisCl.ListNotifications(ctx, &health.ListNotificationsRequest{UserId: userID})
+		assert.NoError(t, err)
+		assert.Len(t, page.GetNotifications(), 2)
+	})
+
+	t.Run("MarkAllRead", func(t *testing.T) {
+		updated, err := redisCl.MarkAllRead(ctx, userID)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, updated)
+
+		count, err := redisCl.GetUnreadCount(ctx, userID)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 0, count)
+	})
+
+	t.Run("StreamNotifications", func(t *testing.T) {
+		page, err := redisCl.ListNotifications(ctx, &health.ListNotificationsRequest{UserId: userID, PageSize: 1})
+		assert.NoError(t, err)
+		lastSeenID := page.GetNotifications()[0].Id
+
+		// Missed while disconnected, so it is replayed
+		assert.NoError(t, redisCl.AddNotification(ctx, userID, "fourth"))
+
+		streamCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		received := make(chan *health.Notification, 10)
+		done := make(chan error, 1)
+		go func() {
+			done <- redisCl.StreamNotifications(streamCtx, userID, lastSeenID, func(n *health.Notification) error {
+				received <- n
+				return nil
+			})
+		}()
+
+		select {
+		case n := <-received:
+			assert.Equal(t, "fourth", n.Message)
+		case <-time.After(5 * time.Second):
+			t.Fatal("replayed notification not received")
+		}
+
+		assert.NoError(t, redisCl.AddNotification(ctx, userID, "fifth"))
+		select {
+		case n := <-received:
+			assert.Equal(t, "fifth", n.Message)
+			assert.False(t, n.Read)
+		case <-time.After(5 * time.Second):
+			t.Fatal("live notification not received")
+		}
+
+		cancel()
+		assert.ErrorIs(t, <-done, context.Canceled)
+	})
+}
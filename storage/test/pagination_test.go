@@ -0,0 +1,37 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseOrderBy(t *testing.T) {
+	tests := []struct {
+		orderBy string
+		key     string
+		desc    bool
+		code    codes.Code
+	}{
+		{orderBy: "", key: storage.OrderByCreatedAt, desc: true},
+		{orderBy: "created_at", key: storage.OrderByCreatedAt},
+		{orderBy: "id", key: storage.OrderByID},
+		{orderBy: "created_at asc", key: storage.OrderByCreatedAt},
+		{orderBy: "CREATED_AT DESC", key: storage.OrderByCreatedAt, desc: true},
+		{orderBy: "id desc", key: storage.OrderByID, desc: true},
+		{orderBy: "priority", code: codes.InvalidArgument},
+		{orderBy: "id sideways", code: codes.InvalidArgument},
+		{orderBy: "id asc desc", code: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		key, desc, err := storage.ParseOrderBy(tt.orderBy)
+		assert.Equal(t, tt.code, status.Code(err), "order_by %q", tt.orderBy)
+		if err == nil {
+			assert.Equal(t, tt.key, key, "order_by %q", tt.orderBy)
+			assert.Equal(t, tt.desc, desc, "order_by %q", tt.orderBy)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	mongodb "github.com/health-analytics-service/health-analytics-service/storage/mongo"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestRetentionRepo(t *testing.T) {
+	db := createMongoDBConnection(t)
+	retentionRepo := mongodb.NewRetentionRepo(db)
+	lifestyleDataRepo := mongodb.NewLifestyleDataRepo(db)
+
+	t.Run("PurgeDeleted", func(t *testing.T) {
+		// 1. Create a deleted and a live record
+		dataValue, err := anypb.New(&health.SleepData{SleepDuration: int64(8 * time.Hour), SleepQuality: "Good"})
+		assert.NoError(t, err, "Failed to create Any proto message")
+		deleted, err := lifestyleDataRepo.CreateLifestyleData(context.Background(), &health.LifestyleData{
+			UserId:       uuid.NewString(),
+			DataType:     "Sleep",
+			DataValue:    dataValue,
+			RecordedDate: time.Now().Format("2006-01-02"),
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, lifestyleDataRepo.DeleteLifestyleData(context.Background(), deleted.Id))
+
+		live, err := lifestyleDataRepo.CreateLifestyleData(context.Background(), &health.LifestyleData{
+			UserId:       uuid.NewString(),
+			DataType:     "Sleep",
+			DataValue:    dataValue,
+			RecordedDate: time.Now().Format("2006-01-02"),
+		})
+		assert.NoError(t, err)
+		defer lifestyleDataRepo.DeleteLifestyleData(context.Background(), live.Id)
+
+		// 2. Records deleted after the cutoff are kept
+		_, err = retentionRepo.PurgeDeleted(context.Background(), time.Now().Add(-time.Hour))
+		assert.NoError(t, err)
+		_, err = lifestyleDataRepo.RestoreLifestyleData(context.Background(), deleted.Id)
+		assert.NoError(t, err, "Recently deleted record should still be restorable")
+		assert.NoError(t, lifestyleDataRepo.DeleteLifestyleData(context.Background(), deleted.Id))
+
+		// 3. Records deleted before the cutoff are removed for good
+		purged, err := retentionRepo.PurgeDeleted(context.Background(), time.Now().Add(time.Minute))
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, purged, int64(1), "PurgeDeleted should remove the deleted record")
+
+		_, err = lifestyleDataRepo.RestoreLifestyleData(context.Background(), deleted.Id)
+		assert.Equal(t, codes.NotFound, status.Code(err), "Purged record should not be restorable")
+		_, err = lifestyleDataRepo.GetLifestyleData(context.Background(), live.Id)
+		assert.NoError(t, err, "Live records should not be purged")
+	})
+}
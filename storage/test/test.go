@@ -3,11 +3,16 @@ package test
 import (
 	"context"
 	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
 	"testing"
 
 	"github.com/health-analytics-service/health-analytics-service/config"
+	"github.com/health-analytics-service/health-analytics-service/encryption"
 	"github.com/health-analytics-service/health-analytics-service/storage"
 	mongodb "github.com/health-analytics-service/health-analytics-service/storage/mongo"
+	"github.com/health-analytics-service/health-analytics-service/storage/postgres"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -29,18 +34,24 @@ func createMongoDBConnection(t *testing.T) *mongo.Database {
 	return client.Database(cfg.MongoDB)
 }
 
-// StorageM implements the storage.StorageI interface for MongoDB.
-type StorageM struct {
-	db                       *mongo.Database
-	medicalRecordRepo        storage.MedicalRecordRepoI
-	geneticDataRepo          storage.GeneticDataRepoI
-	lifestyleDataRepo        storage.LifestyleDataRepoI
-	wearableDataRepo         storage.WearableDataRepoI
-	healthRecommendationRepo storage.HealthRecommendationRepoI
-	healthMonitoringRepo     storage.HealthMonitoringRepoI
+// testEncryptionKeys is the keyring used by the tests; never use it elsewhere.
+const testEncryptionKeys = "test:MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+
+// newTestCipher creates the field cipher of the MongoDB tests.
+func newTestCipher(db *mongo.Database) (*encryption.Cipher, error) {
+	return newTestKeyStoreCipher(mongodb.NewDataKeyStore(db))
 }
 
-// NewMongoStorage creates a new MongoDB storage instance.
+// newTestKeyStoreCipher creates the field cipher of the tests on the given data key store.
+func newTestKeyStoreCipher(keys encryption.KeyStore) (*encryption.Cipher, error) {
+	keyring, err := encryption.ParseKeyring(testEncryptionKeys)
+	if err != nil {
+		return nil, err
+	}
+	return encryption.NewCipher(keyring, keys, nil), nil
+}
+
+// NewMongoStorageTest creates the MongoDB storage of the tests.
 func NewMongoStorageTest(cfg config.Config) (storage.StorageI, error) {
 	// Construct MongoDB connection URI
 	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI("mongodb://localhost:27017"))
@@ -56,44 +67,44 @@ func NewMongoStorageTest(cfg config.Config) (storage.StorageI, error) {
 	}
 
 	db := client.Database(cfg.MongoDB)
-
-	return &StorageM{
-		db:                       db,
-		medicalRecordRepo:        mongodb.NewMedicalRecordRepo(db),
-		geneticDataRepo:          mongodb.NewGeneticDataRepo(db),
-		lifestyleDataRepo:        mongodb.NewLifestyleDataRepo(db),
-		wearableDataRepo:         mongodb.NewWearableDataRepo(db),
-		healthRecommendationRepo: mongodb.NewHealthRecommendationRepo(db),
-		healthMonitoringRepo:     mongodb.NewHealthMonitoringRepo(db),
-	}, nil
-}
-
-// MedicalRecord returns the MedicalRecordRepoI implementation for MongoDB.
-func (s *StorageM) MedicalRecord() storage.MedicalRecordRepoI {
-	return s.medicalRecordRepo
+	cipher, err := newTestCipher(db)
+	if err != nil {
+		return nil, err
+	}
+	return mongodb.NewStorage(db, cipher), nil
 }
 
-// GeneticData returns the GeneticDataRepoI implementation for MongoDB.
-func (s *StorageM) GeneticData() storage.GeneticDataRepoI {
-	return s.geneticDataRepo
-}
+// postgresMigrations matches the SQL migrations creating the PostgreSQL schema.
+const postgresMigrations = "../../migrations/*.up.sql"
 
-// LifestyleData returns the LifestyleDataRepoI implementation for MongoDB.
-func (s *StorageM) LifestyleData() storage.LifestyleDataRepoI {
-	return s.lifestyleDataRepo
-}
-
-// WearableData returns the WearableDataRepoI implementation for MongoDB.
-func (s *StorageM) WearableData() storage.WearableDataRepoI {
-	return s.wearableDataRepo
-}
+// NewPostgresStorageTest creates the PostgreSQL storage of the tests, applying the
+// schema migrations first.
+func NewPostgresStorageTest(cfg config.Config) (storage.StorageI, error) {
+	db, err := postgres.Connect(cfg.PostgresHostTest, cfg.PostgresPortTest, cfg.PostgresUserTest, cfg.PostgresPasswordTest, cfg.PostgresDBTest)
+	if err != nil {
+		return nil, err
+	}
 
-// HealthRecommendation returns the HealthRecommendationRepoI implementation for MongoDB.
-func (s *StorageM) HealthRecommendation() storage.HealthRecommendationRepoI {
-	return s.healthRecommendationRepo
-}
+	// Apply the migrations in order; they are idempotent
+	files, err := filepath.Glob(postgresMigrations)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		migration, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := db.ExecContext(context.Background(), string(migration)); err != nil {
+			slog.Warn("Unable to apply migration " + file + ":" + err.Error())
+			return nil, err
+		}
+	}
 
-// HealthMonitoring returns the HealthMonitoringRepoI implementation for MongoDB.
-func (s *StorageM) HealthMonitoring() storage.HealthMonitoringRepoI {
-	return s.healthMonitoringRepo
+	cipher, err := newTestKeyStoreCipher(postgres.NewDataKeyStore(db))
+	if err != nil {
+		return nil, err
+	}
+	return postgres.NewStorage(db, cipher), nil
 }
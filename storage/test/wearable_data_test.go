@@ -9,6 +9,8 @@ import (
 	"github.com/health-analytics-service/health-analytics-service/genproto/health"
 	mongodb "github.com/health-analytics-service/health-analytics-service/storage/mongo"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
@@ -23,7 +25,7 @@ func TestWearableDataRepo(t *testing.T) {
 		dataValue, err := anypb.New(&health.HeartRateData{
 			UserId:            uuid.NewString(),
 			HeartRate:         80,
-			RecordedTimestamp: time.Now().Format(time.RFC3339),
+			RecordedTimestamp: time.Now().UTC().Format(time.RFC3339),
 		})
 		assert.NoError(t, err, "Failed to create Any proto message")
 
@@ -32,9 +34,10 @@ func TestWearableDataRepo(t *testing.T) {
 			DeviceType:        "Smartwatch",
 			DataType:          "HeartRate",
 			DataValue:         dataValue,
-			RecordedTimestamp: time.Now().Format(time.RFC3339),
+			RecordedTimestamp: time.Now().UTC().Format(time.RFC3339),
 		}
-		createdID, err := wearableDataRepo.CreateWearableData(context.Background(), testWearableData)
+		created, err := wearableDataRepo.CreateWearableData(context.Background(), testWearableData)
+		createdID := created.GetId()
 
 		assert.NoError(t, err, "CreateWearableData should not return an error")
 		assert.NotEmpty(t, createdID, "Created wearable data should have a valid ID")
@@ -45,7 +48,7 @@ func TestWearableDataRepo(t *testing.T) {
 		dataValue, err := anypb.New(&health.HeartRateData{
 			UserId:            uuid.NewString(),
 			HeartRate:         80,
-			RecordedTimestamp: time.Now().Format(time.RFC3339),
+			RecordedTimestamp: time.Now().UTC().Format(time.RFC3339),
 		})
 		assert.NoError(t, err, "Failed to create Any proto message")
 
@@ -54,9 +57,10 @@ func TestWearableDataRepo(t *testing.T) {
 			DeviceType:        "Smartwatch",
 			DataType:          "HeartRate",
 			DataValue:         dataValue,
-			RecordedTimestamp: time.Now().Format(time.RFC3339),
+			RecordedTimestamp: time.Now().UTC().Format(time.RFC3339),
 		}
-		createdID, err := wearableDataRepo.CreateWearableData(context.Background(), testWearableData)
+		created, err := wearableDataRepo.CreateWearableData(context.Background(), testWearableData)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating wearable data for GetWearableData test failed")
 		assert.NotEmpty(t, createdID, "Created wearable data should have a valid ID")
 
@@ -78,7 +82,7 @@ func TestWearableDataRepo(t *testing.T) {
 		dataValue, err := anypb.New(&health.HeartRateData{
 			UserId:            uuid.NewString(),
 			HeartRate:         80,
-			RecordedTimestamp: time.Now().Format(time.RFC3339),
+			RecordedTimestamp: time.Now().UTC().Format(time.RFC3339),
 		})
 		assert.NoError(t, err, "Failed to create Any proto message")
 
@@ -87,9 +91,10 @@ func TestWearableDataRepo(t *testing.T) {
 			DeviceType:        "Smartwatch",
 			DataType:          "HeartRate",
 			DataValue:         dataValue,
-			RecordedTimestamp: time.Now().Format(time.RFC3339),
+			RecordedTimestamp: time.Now().UTC().Format(time.RFC3339),
 		}
-		createdID, err := wearableDataRepo.CreateWearableData(context.Background(), testWearableData)
+		created, err := wearableDataRepo.CreateWearableData(context.Background(), testWearableData)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating wearable data for UpdateWearableData test failed")
 		assert.NotEmpty(t, createdID, "Created wearable data should have a valid ID")
 
@@ -97,7 +102,7 @@ func TestWearableDataRepo(t *testing.T) {
 		updatedDataValue, err := anypb.New(&health.HeartRateData{
 			UserId:            uuid.NewString(),
 			HeartRate:         90,
-			RecordedTimestamp: time.Now().Add(time.Hour).Format(time.RFC3339),
+			RecordedTimestamp: time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
 		})
 		assert.NoError(t, err, "Failed to create updated Any proto message")
 
@@ -107,10 +112,11 @@ func TestWearableDataRepo(t *testing.T) {
 			DeviceType:        "Fitness Tracker",
 			DataType:          "Updated HeartRate",
 			DataValue:         updatedDataValue,
-			RecordedTimestamp: time.Now().Add(time.Hour).Format(time.RFC3339),
+			RecordedTimestamp: time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
 		}
-		err = wearableDataRepo.UpdateWearableData(context.Background(), updateRecord)
+		updated, err := wearableDataRepo.UpdateWearableData(context.Background(), updateRecord)
 		assert.NoError(t, err, "UpdateWearableData should not return an error")
+		assert.Equal(t, createdID, updated.GetId(), "Update should return the updated resource")
 
 		// 3. Retrieve the record and verify the update
 		retrievedRecord, err := wearableDataRepo.GetWearableData(context.Background(), createdID)
@@ -128,7 +134,7 @@ func TestWearableDataRepo(t *testing.T) {
 		dataValue, err := anypb.New(&health.HeartRateData{
 			UserId:            uuid.NewString(),
 			HeartRate:         80,
-			RecordedTimestamp: time.Now().Format(time.RFC3339),
+			RecordedTimestamp: time.Now().UTC().Format(time.RFC3339),
 		})
 		assert.NoError(t, err, "Failed to create Any proto message")
 
@@ -137,9 +143,10 @@ func TestWearableDataRepo(t *testing.T) {
 			DeviceType:        "Smartwatch",
 			DataType:          "HeartRate",
 			DataValue:         dataValue,
-			RecordedTimestamp: time.Now().Format(time.RFC3339),
+			RecordedTimestamp: time.Now().UTC().Format(time.RFC3339),
 		}
-		createdID, err := wearableDataRepo.CreateWearableData(context.Background(), testWearableData)
+		created, err := wearableDataRepo.CreateWearableData(context.Background(), testWearableData)
+		createdID := created.GetId()
 		assert.NoError(t, err, "Creating wearable data for DeleteWearableData test failed")
 		assert.NotEmpty(t, createdID, "Created wearable data should have a valid ID")
 
@@ -153,6 +160,42 @@ func TestWearableDataRepo(t *testing.T) {
 		assert.Nil(t, retrievedRecord, "GetWearableData response should be nil after delete")
 	})
 
+	t.Run("TypedWearablePayload", func(t *testing.T) {
+		// 1. Create a record with a typed steps payload
+		dataValue, err := anypb.New(&health.StepsData{
+			UserId:            uuid.NewString(),
+			Steps:             8421,
+			RecordedTimestamp: time.Now().UTC().Format(time.RFC3339),
+		})
+		assert.NoError(t, err, "Failed to create Any proto message")
+
+		created, err := wearableDataRepo.CreateWearableData(context.Background(), &health.WearableData{
+			UserId:            uuid.NewString(),
+			DeviceType:        "Fitness Tracker",
+			DataType:          "steps",
+			DataValue:         dataValue,
+			RecordedTimestamp: time.Now().UTC().Format(time.RFC3339),
+		})
+		assert.NoError(t, err, "CreateWearableData should accept a matching payload")
+
+		// 2. The payload should round-trip through its BSON sub-document
+		retrievedData, err := wearableDataRepo.GetWearableData(context.Background(), created.GetId())
+		assert.NoError(t, err, "GetWearableData should not return an error")
+		steps := &health.StepsData{}
+		assert.NoError(t, retrievedData.DataValue.UnmarshalTo(steps), "DataValue should hold StepsData")
+		assert.Equal(t, int64(8421), steps.Steps)
+
+		// 3. A payload that does not match the data type should be rejected
+		_, err = wearableDataRepo.CreateWearableData(context.Background(), &health.WearableData{
+			UserId:            uuid.NewString(),
+			DeviceType:        "Fitness Tracker",
+			DataType:          "sleep",
+			DataValue:         dataValue,
+			RecordedTimestamp: time.Now().UTC().Format(time.RFC3339),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "CreateWearableData should reject a mismatched payload")
+	})
+
 	t.Run("ListWearableData", func(t *testing.T) {
 		// 1. Create some records for a specific user
 		userID := uuid.NewString()
@@ -162,14 +205,14 @@ func TestWearableDataRepo(t *testing.T) {
 				DeviceType:        "Smartwatch",
 				DataType:          "HeartRate 1",
 				DataValue:         createSampleAny(t),
-				RecordedTimestamp: time.Now().Format(time.RFC3339),
+				RecordedTimestamp: time.Now().UTC().Format(time.RFC3339),
 			},
 			{
 				UserId:            userID,
 				DeviceType:        "Fitness Tracker",
 				DataType:          "HeartRate 2",
 				DataValue:         createSampleAny(t),
-				RecordedTimestamp: time.Now().Add(time.Hour).Format(time.RFC3339),
+				RecordedTimestamp: time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
 			},
 		}
 
@@ -182,7 +225,8 @@ func TestWearableDataRepo(t *testing.T) {
 		req := &health.ListWearableDataRequest{
 			UserId: userID,
 		}
-		retrievedRecords, err := wearableDataRepo.ListWearableData(context.Background(), req)
+		retrievedRecordsPage, err := wearableDataRepo.ListWearableData(context.Background(), req)
+		retrievedRecords := retrievedRecordsPage.GetWearableData()
 		assert.NoError(t, err, "ListWearableData should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListWearableData response should not be nil")
 		assert.GreaterOrEqual(t, len(retrievedRecords), 2, "Should have at least two wearable data records for the user")
@@ -192,7 +236,8 @@ func TestWearableDataRepo(t *testing.T) {
 			UserId:     userID,
 			DeviceType: "Smartwatch",
 		}
-		retrievedRecords, err = wearableDataRepo.ListWearableData(context.Background(), req)
+		retrievedRecordsPage, err = wearableDataRepo.ListWearableData(context.Background(), req)
+		retrievedRecords = retrievedRecordsPage.GetWearableData()
 		assert.NoError(t, err, "ListWearableData should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListWearableData response should not be nil")
 		assert.Equal(t, 1, len(retrievedRecords), "Should have one wearable data record matching the filter")
@@ -203,7 +248,8 @@ func TestWearableDataRepo(t *testing.T) {
 			UserId:   userID,
 			DataType: "HeartRate 1",
 		}
-		retrievedRecords, err = wearableDataRepo.ListWearableData(context.Background(), req)
+		retrievedRecordsPage, err = wearableDataRepo.ListWearableData(context.Background(), req)
+		retrievedRecords = retrievedRecordsPage.GetWearableData()
 		assert.NoError(t, err, "ListWearableData should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListWearableData response should not be nil")
 		assert.Equal(t, 1, len(retrievedRecords), "Should have one wearable data record matching the filter")
@@ -215,10 +261,31 @@ func TestWearableDataRepo(t *testing.T) {
 			UserId:            userID,
 			RecordedTimestamp: recordedTimestamp,
 		}
-		retrievedRecords, err = wearableDataRepo.ListWearableData(context.Background(), req)
+		retrievedRecordsPage, err = wearableDataRepo.ListWearableData(context.Background(), req)
+		retrievedRecords = retrievedRecordsPage.GetWearableData()
 		assert.NoError(t, err, "ListWearableData should not return an error")
 		assert.NotNil(t, retrievedRecords, "ListWearableData response should not be nil")
 		assert.Equal(t, 1, len(retrievedRecords), "Should have one wearable data record matching the filter")
 		assert.Equal(t, recordedTimestamp, retrievedRecords[0].RecordedTimestamp, "RecordedTimestamp should match the filter")
+
+		// 6. Test filtering by a RecordedTimestamp range
+		req = &health.ListWearableDataRequest{
+			UserId:                userID,
+			RecordedTimestampFrom: time.Now().Add(30 * time.Minute).UTC().Format(time.RFC3339),
+			RecordedTimestampTo:   time.Now().Add(2 * time.Hour).UTC().Format(time.RFC3339),
+		}
+		retrievedRecordsPage, err = wearableDataRepo.ListWearableData(context.Background(), req)
+		retrievedRecords = retrievedRecordsPage.GetWearableData()
+		assert.NoError(t, err, "ListWearableData should not return an error")
+		assert.Equal(t, 1, len(retrievedRecords), "Should have one wearable data record within the range")
+		assert.Equal(t, testRecords[1].RecordedTimestamp, retrievedRecords[0].RecordedTimestamp, "RecordedTimestamp should be within the range")
+
+		// 7. Test rejecting a malformed range
+		req = &health.ListWearableDataRequest{
+			UserId:                userID,
+			RecordedTimestampFrom: "yesterday",
+		}
+		_, err = wearableDataRepo.ListWearableData(context.Background(), req)
+		assert.Error(t, err, "ListWearableData should reject a malformed timestamp")
 	})
 }
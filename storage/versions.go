@@ -0,0 +1,27 @@
+package storage
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+)
+
+// VersionPageToken encodes the version before which the next page of versions (listed
+// newest first) starts.
+func VersionPageToken(version int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(version, 10)))
+}
+
+// ParseVersionPageToken decodes a token returned by VersionPageToken.
+func ParseVersionPageToken(token string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errs.InvalidArgument("page_token", "invalid page_token")
+	}
+	version, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || version < 1 {
+		return 0, errs.InvalidArgument("page_token", "invalid page_token")
+	}
+	return version, nil
+}
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// WearablePayload describes the typed data_value payload of a known wearable data type.
+type WearablePayload struct {
+	// DataType is the canonical data type name.
+	DataType string
+	// New returns an empty payload message.
+	New func() proto.Message
+	// Value returns the primary numeric reading of the payload, aggregated in summaries.
+	Value func(proto.Message) float64
+}
+
+// wearablePayloads lists the known wearable data types.
+var wearablePayloads = []WearablePayload{
+	{
+		DataType: "sleep",
+		New:      func() proto.Message { return &health.SleepData{} },
+		Value:    func(m proto.Message) float64 { return float64(m.(*health.SleepData).SleepDuration) },
+	},
+	{
+		DataType: "heart_rate",
+		New:      func() proto.Message { return &health.HeartRateData{} },
+		Value:    func(m proto.Message) float64 { return float64(m.(*health.HeartRateData).HeartRate) },
+	},
+	{
+		DataType: "steps",
+		New:      func() proto.Message { return &health.StepsData{} },
+		Value:    func(m proto.Message) float64 { return float64(m.(*health.StepsData).Steps) },
+	},
+	{
+		DataType: "spo2",
+		New:      func() proto.Message { return &health.SpO2Data{} },
+		Value:    func(m proto.Message) float64 { return m.(*health.SpO2Data).Percentage },
+	},
+	{
+		DataType: "blood_pressure",
+		New:      func() proto.Message { return &health.BloodPressureData{} },
+		Value:    func(m proto.Message) float64 { return float64(m.(*health.BloodPressureData).Systolic) },
+	},
+	{
+		DataType: "calories",
+		New:      func() proto.Message { return &health.CaloriesData{} },
+		Value:    func(m proto.Message) float64 { return m.(*health.CaloriesData).Calories },
+	},
+}
+
+// LookupWearablePayload returns the payload description of a wearable data type.
+// Data types are matched ignoring case and separators, so "HeartRate" and
+// "heart_rate" are the same type.
+func LookupWearablePayload(dataType string) (WearablePayload, bool) {
+	key := normalizeDataType(dataType)
+	for _, payload := range wearablePayloads {
+		if normalizeDataType(payload.DataType) == key {
+			return payload, true
+		}
+	}
+	return WearablePayload{}, false
+}
+
+// WearableDataTypes returns the canonical names of the known wearable data types.
+func WearableDataTypes() []string {
+	types := make([]string, len(wearablePayloads))
+	for i, payload := range wearablePayloads {
+		types[i] = payload.DataType
+	}
+	return types
+}
+
+// UnpackWearablePayload validates data_value against the data type and returns the
+// decoded payload. It returns nil without error for data types that are not known,
+// whose payloads are kept opaque.
+func UnpackWearablePayload(dataType string, dataValue *anypb.Any) (proto.Message, *WearablePayload, error) {
+	payload, ok := LookupWearablePayload(dataType)
+	if !ok {
+		return nil, nil, nil
+	}
+	if dataValue == nil {
+		return nil, nil, errs.InvalidArgument("data_value", "data_value is required for data_type %q", dataType)
+	}
+
+	msg := payload.New()
+	if !dataValue.MessageIs(msg) {
+		return nil, nil, errs.InvalidArgument("data_value", "data_value of type %q does not match data_type %q, expected %s",
+			dataValue.GetTypeUrl(), dataType, msg.ProtoReflect().Descriptor().FullName())
+	}
+	if err := dataValue.UnmarshalTo(msg); err != nil {
+		return nil, nil, errs.InvalidArgument("data_value", "invalid data_value for data_type %q: %v", dataType, err)
+	}
+	return msg, &payload, nil
+}
+
+// WearableValue returns the primary numeric reading of a wearable payload message
+// (heart rate, sleep duration, step count, ...). Plain wrapper messages such as
+// google.protobuf.Int64Value are accepted as well.
+func WearableValue(msg proto.Message) (float64, bool) {
+	for _, payload := range wearablePayloads {
+		if payload.New().ProtoReflect().Descriptor().FullName() == msg.ProtoReflect().Descriptor().FullName() {
+			return payload.Value(msg), true
+		}
+	}
+
+	switch v := msg.(type) {
+	case *wrapperspb.Int32Value:
+		return float64(v.Value), true
+	case *wrapperspb.Int64Value:
+		return float64(v.Value), true
+	case *wrapperspb.UInt32Value:
+		return float64(v.Value), true
+	case *wrapperspb.UInt64Value:
+		return float64(v.Value), true
+	case *wrapperspb.FloatValue:
+		return float64(v.Value), true
+	case *wrapperspb.DoubleValue:
+		return v.Value, true
+	default:
+		return 0, false
+	}
+}
+
+// normalizeDataType lower-cases a data type and strips everything but letters and digits.
+func normalizeDataType(dataType string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, dataType)
+}
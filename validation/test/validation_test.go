@@ -0,0 +1,187 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/validation"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// violatedFields returns the fields listed in the BadRequest details of err.
+func violatedFields(t *testing.T, err error) []string {
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	return fields
+}
+
+func TestMedicalRecord(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		record := &health.MedicalRecord{
+			UserId:     "user-1",
+			RecordType: "Lab Result",
+			RecordDate: "2024-03-01",
+		}
+		assert.NoError(t, validation.MedicalRecord(record, validation.Create))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		record := &health.MedicalRecord{
+			RecordType:  "horoscope",
+			RecordDate:  "yesterday",
+			Attachments: []string{""},
+		}
+		err := validation.MedicalRecord(record, validation.Update)
+		assert.ElementsMatch(t, []string{"id", "user_id", "record_type", "record_date", "attachments[0]"}, violatedFields(t, err))
+	})
+}
+
+func TestGeneticData(t *testing.T) {
+	dataValue, err := anypb.New(wrapperspb.String("ACGT"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, validation.GeneticData(&health.GeneticData{
+		UserId:       "user-1",
+		DataType:     "DNA Sequencing",
+		DataValue:    dataValue,
+		AnalysisDate: "2024-03-01",
+	}, validation.Create))
+
+	err = validation.GeneticData(&health.GeneticData{
+		UserId:       "user-1",
+		DataType:     "palm reading",
+		AnalysisDate: "01/03/2024",
+	}, validation.Create)
+	assert.ElementsMatch(t, []string{"data_type", "data_value", "analysis_date"}, violatedFields(t, err))
+}
+
+func TestLifestyleData(t *testing.T) {
+	dataValue, err := anypb.New(wrapperspb.Int64(8))
+	assert.NoError(t, err)
+
+	assert.NoError(t, validation.LifestyleData(&health.LifestyleData{
+		Id:           "65f1c0a2e4b0a1b2c3d4e5f6",
+		UserId:       "user-1",
+		DataType:     "Sleep",
+		DataValue:    dataValue,
+		RecordedDate: "2024-03-01",
+	}, validation.Update))
+
+	err = validation.LifestyleData(&health.LifestyleData{DataType: "Sleep", DataValue: dataValue}, validation.Create)
+	assert.ElementsMatch(t, []string{"user_id", "recorded_date"}, violatedFields(t, err))
+}
+
+func TestWearableData(t *testing.T) {
+	heartRate, err := anypb.New(&health.HeartRateData{HeartRate: 72})
+	assert.NoError(t, err)
+
+	assert.NoError(t, validation.WearableData(&health.WearableData{
+		UserId:            "user-1",
+		DeviceType:        "Smartwatch",
+		DataType:          "HeartRate",
+		DataValue:         heartRate,
+		RecordedTimestamp: "2024-03-01T08:00:00Z",
+	}, validation.Create))
+
+	t.Run("MismatchedPayload", func(t *testing.T) {
+		err := validation.WearableData(&health.WearableData{
+			UserId:            "user-1",
+			DeviceType:        "Smartwatch",
+			DataType:          "steps",
+			DataValue:         heartRate,
+			RecordedTimestamp: "2024-03-01 08:00",
+		}, validation.Create)
+		assert.ElementsMatch(t, []string{"data_value", "recorded_timestamp"}, violatedFields(t, err))
+	})
+
+	t.Run("UnknownDataType", func(t *testing.T) {
+		err := validation.WearableData(&health.WearableData{
+			UserId:            "user-1",
+			DeviceType:        "Smartwatch",
+			DataType:          "mood",
+			DataValue:         heartRate,
+			RecordedTimestamp: "2024-03-01T08:00:00Z",
+		}, validation.Create)
+		assert.ElementsMatch(t, []string{"data_type"}, violatedFields(t, err))
+	})
+}
+
+func TestHealthRecommendation(t *testing.T) {
+	assert.NoError(t, validation.HealthRecommendation(&health.HealthRecommendation{
+		UserId:             "user-1",
+		RecommendationType: "Exercise",
+		Description:        "Walk 30 minutes a day",
+		Priority:           2,
+	}, validation.Create))
+
+	err := validation.HealthRecommendation(&health.HealthRecommendation{
+		UserId:             "user-1",
+		RecommendationType: "Exercise",
+		Description:        " ",
+		Priority:           -1,
+	}, validation.Create)
+	assert.ElementsMatch(t, []string{"description", "priority"}, violatedFields(t, err))
+
+	err = validation.HealthRecommendation(&health.HealthRecommendation{
+		Id:                 "rec-1",
+		UserId:             "user-1",
+		RecommendationType: "Exercise",
+		Description:        "Walk 30 minutes a day",
+		Priority:           2,
+		ExpectedVersion:    -1,
+	}, validation.Update)
+	assert.ElementsMatch(t, []string{"expected_version"}, violatedFields(t, err))
+}
+
+func TestUpdateMask(t *testing.T) {
+	// Only the masked fields are validated
+	assert.NoError(t, validation.HealthRecommendation(&health.HealthRecommendation{
+		Id:         "rec-1",
+		Priority:   4,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"priority"}},
+	}, validation.Update))
+
+	err := validation.MedicalRecord(&health.MedicalRecord{
+		Id:          "record-1",
+		Attachments: []string{""},
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"attachments", "record_date"}},
+	}, validation.Update)
+	assert.ElementsMatch(t, []string{"attachments[0]", "record_date"}, violatedFields(t, err))
+
+	// Masks must name updatable fields, of updates only
+	err = validation.LifestyleData(&health.LifestyleData{
+		Id:         "data-1",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"id", "created_at"}},
+	}, validation.Update)
+	assert.ElementsMatch(t, []string{"update_mask", "update_mask"}, violatedFields(t, err))
+
+	err = validation.GeneticData(&health.GeneticData{
+		UserId:     "user-1",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"user_id"}},
+	}, validation.Create)
+	assert.ElementsMatch(t, []string{"update_mask"}, violatedFields(t, err))
+
+	// Wearable payloads are typed by data_type, so both are updated together
+	err = validation.WearableData(&health.WearableData{
+		Id:         "data-1",
+		DataType:   "steps",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"data_type"}},
+	}, validation.Update)
+	assert.ElementsMatch(t, []string{"update_mask"}, violatedFields(t, err))
+}
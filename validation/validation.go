@@ -0,0 +1,243 @@
+// Package validation checks health entities before they are stored, for both the
+// gRPC services and the Kafka consumers. Failures are InvalidArgument status errors
+// listing every offending field.
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/health-analytics-service/health-analytics-service/errs"
+	"github.com/health-analytics-service/health-analytics-service/genproto/health"
+	"github.com/health-analytics-service/health-analytics-service/storage"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Mode tells whether an entity is validated for creation or for an update.
+type Mode int
+
+const (
+	// Create validates a new entity; its id is optional.
+	Create Mode = iota
+	// Update validates a replacement of an existing entity; its id is required. With an
+	// update_mask, only the masked fields are validated.
+	Update
+)
+
+const (
+	dateLayout  = "2006-01-02"
+	minPriority = 1
+	maxPriority = 5
+)
+
+// Enumerated type values. Values are matched ignoring case and separators, so
+// "Lab Result", "lab-result" and "LabResult" are all accepted as "lab_result".
+var (
+	RecordTypes = []string{
+		"diagnosis", "lab_result", "imaging", "prescription", "procedure", "vaccination",
+		"consultation", "genetic_test", "allergy", "other",
+	}
+	GeneticDataTypes = []string{
+		"dna_sequencing", "snp_genotyping", "whole_genome", "whole_exome", "pharmacogenomics",
+		"ancestry", "carrier_screening", "other",
+	}
+	LifestyleDataTypes = []string{
+		"sleep", "diet", "exercise", "alcohol", "smoking", "stress", "hydration", "other",
+	}
+	RecommendationTypes = []string{
+		"diet", "exercise", "sleep", "hydration", "medication", "lifestyle", "screening",
+		"mental_health", "other",
+	}
+)
+
+// Updatable fields of each entity, which are the valid update_mask paths.
+var (
+	MedicalRecordFields        = []string{"user_id", "record_type", "record_date", "description", "doctor_id", "attachments"}
+	GeneticDataFields          = []string{"user_id", "data_type", "data_value", "analysis_date"}
+	LifestyleDataFields        = []string{"user_id", "data_type", "data_value", "recorded_date"}
+	WearableDataFields         = []string{"user_id", "device_type", "data_type", "data_value", "recorded_timestamp"}
+	HealthRecommendationFields = []string{"user_id", "recommendation_type", "description", "priority"}
+)
+
+// violations collects the invalid fields of an entity.
+type violations []errs.FieldViolation
+
+func (v *violations) add(field, format string, args ...interface{}) {
+	*v = append(*v, errs.FieldViolation{Field: field, Description: fmt.Sprintf(format, args...)})
+}
+
+func (v *violations) id(id string, mode Mode) {
+	if mode == Update && id == "" {
+		v.add("id", "id is required")
+	}
+}
+
+func (v *violations) expectedVersion(version int64) {
+	if version < 0 {
+		v.add("expected_version", "expected_version must not be negative")
+	}
+}
+
+func (v *violations) required(field, value string) bool {
+	if strings.TrimSpace(value) == "" {
+		v.add(field, "%s is required", field)
+		return false
+	}
+	return true
+}
+
+func (v *violations) date(field, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	if _, err := time.Parse(dateLayout, value); err != nil {
+		v.add(field, "invalid %s %q: expected YYYY-MM-DD", field, value)
+	}
+}
+
+func (v *violations) timestamp(field, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		v.add(field, "invalid %s %q: expected an RFC3339 timestamp", field, value)
+	}
+}
+
+func (v *violations) oneOf(field, value string, allowed []string) {
+	if !v.required(field, value) {
+		return
+	}
+	key := normalize(value)
+	for _, a := range allowed {
+		if normalize(a) == key {
+			return
+		}
+	}
+	v.add(field, "invalid %s %q: must be one of %s", field, value, strings.Join(allowed, ", "))
+}
+
+func (v violations) err() error {
+	return errs.InvalidFields(v...)
+}
+
+// masked returns the violations of the fields written by an update with the given
+// update_mask, after checking that the mask only names updatable fields. Without a
+// mask, all violations are returned.
+func (v violations) masked(mode Mode, mask *fieldmaskpb.FieldMask, updatable []string) error {
+	if len(mask.GetPaths()) == 0 {
+		return v.err()
+	}
+	if mode != Update {
+		return errs.InvalidArgument("update_mask", "update_mask is only supported by updates")
+	}
+
+	var kept violations
+	for _, path := range mask.GetPaths() {
+		if !slices.Contains(updatable, path) {
+			kept.add("update_mask", "invalid update_mask path %q: must be one of %s", path, strings.Join(updatable, ", "))
+		}
+	}
+	for _, violation := range v {
+		field, _, _ := strings.Cut(violation.Field, "[")
+		if field == "id" || field == "expected_version" || field == "update_mask" || storage.MaskIncludes(mask, field) {
+			kept = append(kept, violation)
+		}
+	}
+	return kept.err()
+}
+
+// normalize lower-cases a value and strips everything but letters and digits.
+func normalize(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, value)
+}
+
+// MedicalRecord validates a medical record.
+func MedicalRecord(record *health.MedicalRecord, mode Mode) error {
+	var v violations
+	v.id(record.Id, mode)
+	v.expectedVersion(record.ExpectedVersion)
+	v.required("user_id", record.UserId)
+	v.oneOf("record_type", record.RecordType, RecordTypes)
+	v.date("record_date", record.RecordDate)
+	for i, attachment := range record.Attachments {
+		if strings.TrimSpace(attachment) == "" {
+			v.add(fmt.Sprintf("attachments[%d]", i), "attachments must not be empty")
+		}
+	}
+	return v.masked(mode, record.UpdateMask, MedicalRecordFields)
+}
+
+// GeneticData validates genetic data.
+func GeneticData(data *health.GeneticData, mode Mode) error {
+	var v violations
+	v.id(data.Id, mode)
+	v.expectedVersion(data.ExpectedVersion)
+	v.required("user_id", data.UserId)
+	v.oneOf("data_type", data.DataType, GeneticDataTypes)
+	if data.DataValue == nil {
+		v.add("data_value", "data_value is required")
+	}
+	v.date("analysis_date", data.AnalysisDate)
+	return v.masked(mode, data.UpdateMask, GeneticDataFields)
+}
+
+// LifestyleData validates lifestyle data.
+func LifestyleData(data *health.LifestyleData, mode Mode) error {
+	var v violations
+	v.id(data.Id, mode)
+	v.expectedVersion(data.ExpectedVersion)
+	v.required("user_id", data.UserId)
+	v.oneOf("data_type", data.DataType, LifestyleDataTypes)
+	if data.DataValue == nil {
+		v.add("data_value", "data_value is required")
+	}
+	v.date("recorded_date", data.RecordedDate)
+	return v.masked(mode, data.UpdateMask, LifestyleDataFields)
+}
+
+// WearableData validates wearable data. The data type must be one of the known
+// wearable payload types and data_value must hold its payload, so both can only be
+// updated together.
+func WearableData(data *health.WearableData, mode Mode) error {
+	var v violations
+	v.id(data.Id, mode)
+	v.expectedVersion(data.ExpectedVersion)
+	v.required("user_id", data.UserId)
+	v.required("device_type", data.DeviceType)
+	if v.required("data_type", data.DataType) {
+		if _, ok := storage.LookupWearablePayload(data.DataType); !ok {
+			v.add("data_type", "invalid data_type %q: must be one of %s", data.DataType, strings.Join(storage.WearableDataTypes(), ", "))
+		} else if _, _, err := storage.UnpackWearablePayload(data.DataType, data.DataValue); err != nil {
+			v.add("data_value", "%s", status.Convert(err).Message())
+		}
+	}
+	v.timestamp("recorded_timestamp", data.RecordedTimestamp)
+	if storage.MaskIncludes(data.UpdateMask, "data_type") != storage.MaskIncludes(data.UpdateMask, "data_value") {
+		v.add("update_mask", "data_type and data_value must be updated together")
+	}
+	return v.masked(mode, data.UpdateMask, WearableDataFields)
+}
+
+// HealthRecommendation validates a health recommendation.
+func HealthRecommendation(recommendation *health.HealthRecommendation, mode Mode) error {
+	var v violations
+	v.id(recommendation.Id, mode)
+	v.expectedVersion(recommendation.ExpectedVersion)
+	v.required("user_id", recommendation.UserId)
+	v.oneOf("recommendation_type", recommendation.RecommendationType, RecommendationTypes)
+	v.required("description", recommendation.Description)
+	if recommendation.Priority < minPriority || recommendation.Priority > maxPriority {
+		v.add("priority", "priority must be between %d and %d, got %d", minPriority, maxPriority, recommendation.Priority)
+	}
+	return v.masked(mode, recommendation.UpdateMask, HealthRecommendationFields)
+}